@@ -43,6 +43,10 @@ type Config struct {
 	Zone                           string
 	Token                          string
 	ServiceAccountKeyFileOrContent string
+	OIDCToken                      string
+	OIDCTokenFile                  string
+	OIDCServiceAccountID           string
+	ImpersonateServiceAccountID    string
 	Plaintext                      bool
 	Insecure                       bool
 	MaxRetries                     int
@@ -50,6 +54,11 @@ type Config struct {
 	YMQEndpoint                    string
 	Region                         string
 
+	// IAM resources wait until their changes are visible in access bindings
+	// for at most IAMPropagationTimeout when IAMWaitPropagation is set.
+	IAMWaitPropagation    bool
+	IAMPropagationTimeout time.Duration
+
 	// These storage access keys are optional and only used when
 	// storage data/resource doesn't have own access keys explicitly specified.
 	StorageAccessKey string
@@ -131,10 +140,22 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 	// Now we will have new request id for every retry attempt.
 	interceptorChain := grpc_middleware.ChainUnaryClient(interceptors...)
 
-	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig,
+	dialOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.userAgent),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
-		grpc.WithUnaryInterceptor(interceptorChain))
+		grpc.WithUnaryInterceptor(interceptorChain),
+	}
+
+	if c.ImpersonateServiceAccountID != "" {
+		// SDK with the provider credentials is used only to mint tokens of the impersonated service account
+		impersonatorSDK, err := ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig, dialOptions...)
+		if err != nil {
+			return err
+		}
+		yandexSDKConfig.Credentials = newImpersonatedServiceAccountCredentials(c.ImpersonateServiceAccountID, impersonatorSDK.CreateIAMTokenForServiceAccount)
+	}
+
+	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig, dialOptions...)
 
 	if err == nil {
 		err = c.initializeDefaultS3Client()
@@ -178,11 +199,15 @@ func (c *Config) credentials() (ycsdk.Credentials, error) {
 		return ycsdk.OAuthToken(c.Token), nil
 	}
 
+	if c.OIDCToken != "" || c.OIDCTokenFile != "" {
+		return newOIDCTokenExchangeCredentials(c.OIDCServiceAccountID, c.OIDCToken, c.OIDCTokenFile)
+	}
+
 	if sa := ycsdk.InstanceServiceAccount(); checkServiceAccountAvailable(c.Context(), sa) {
 		return sa, nil
 	}
 
-	return nil, fmt.Errorf("one of 'token' or 'service_account_key_file' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account")
+	return nil, fmt.Errorf("one of 'token', 'service_account_key_file', 'oidc_token' or 'oidc_token_file' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account")
 }
 
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
@@ -0,0 +1,218 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func dataSourceYandexComputeInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexComputeInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ipv6_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"nat_ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexComputeInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting folder ID to list instances: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while listing instances: %s", err)
+	}
+
+	filter := d.Get("filter").(string)
+
+	var instances []*compute.Instance
+	pageToken := ""
+	for {
+		resp, err := config.sdk.Compute().Instance().List(ctx, &compute.ListInstancesRequest{
+			FolderId:  folderID,
+			Filter:    filter,
+			PageSize:  defaultListSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while getting list of instances in Folder %q: %s", folderID, err)
+		}
+		for _, instance := range resp.Instances {
+			if labelsContain(instance.Labels, labels) {
+				instances = append(instances, instance)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	flattened, err := flattenComputeInstances(instances)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("instances", flattened); err != nil {
+		return err
+	}
+
+	d.Set("folder_id", folderID)
+	d.SetId(listDataSourceID(folderID, filter, labels))
+	return nil
+}
+
+// flattenComputeInstances returns instances ordered by name and ID, so that the list does not depend on API ordering.
+func flattenComputeInstances(instances []*compute.Instance) ([]map[string]interface{}, error) {
+	sort.SliceStable(instances, func(i, j int) bool {
+		if instances[i].Name != instances[j].Name {
+			return instances[i].Name < instances[j].Name
+		}
+		return instances[i].Id < instances[j].Id
+	})
+
+	result := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		nics := make([]map[string]interface{}, 0, len(instance.NetworkInterfaces))
+		for _, iface := range instance.NetworkInterfaces {
+			index, err := strconv.Atoi(iface.Index)
+			if err != nil {
+				return nil, fmt.Errorf("Error while convert index of Network Interface of instance %q: %s", instance.Id, err)
+			}
+
+			nics = append(nics, map[string]interface{}{
+				"index":          index,
+				"subnet_id":      iface.SubnetId,
+				"mac_address":    iface.MacAddress,
+				"ip_address":     iface.GetPrimaryV4Address().GetAddress(),
+				"ipv6_address":   iface.GetPrimaryV6Address().GetAddress(),
+				"nat_ip_address": iface.GetPrimaryV4Address().GetOneToOneNat().GetAddress(),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"instance_id":        instance.Id,
+			"folder_id":          instance.FolderId,
+			"name":               instance.Name,
+			"description":        instance.Description,
+			"labels":             instance.Labels,
+			"zone":               instance.ZoneId,
+			"platform_id":        instance.PlatformId,
+			"fqdn":               instance.Fqdn,
+			"status":             strings.ToLower(instance.Status.String()),
+			"service_account_id": instance.ServiceAccountId,
+			"network_interface":  nics,
+			"created_at":         getTimestamp(instance.CreatedAt),
+		})
+	}
+	return result, nil
+}
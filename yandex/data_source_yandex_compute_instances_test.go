@@ -0,0 +1,134 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func TestAccDataSourceComputeInstances_byLabels(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("data-instances-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeInstancesConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.list", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.list", "instances.0.instance_id",
+						"yandex_compute_instance.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.list", "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.list", "instances.0.status", "running"),
+					resource.TestCheckResourceAttr("data.yandex_compute_instances.list", "instances.0.zone", "ru-central1-a"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_instances.list", "instances.0.network_interface.0.subnet_id",
+						"yandex_vpc_subnet.inst-test-subnet", "id"),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_instances.list", "instances.0.network_interface.0.ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeInstancesConfig(instanceName string) string {
+	return testAccDataSourceComputeInstanceResourceConfig(instanceName) + fmt.Sprintf(`
+data "yandex_compute_instances" "list" {
+  filter = "name=\"%s\""
+  labels = {
+    my_key = "my_value"
+  }
+
+  depends_on = [yandex_compute_instance.foo]
+}
+`, instanceName)
+}
+
+func TestFlattenComputeInstances(t *testing.T) {
+	instances := []*compute.Instance{
+		{
+			Id:         "instance2",
+			FolderId:   "folder1",
+			Name:       "web",
+			ZoneId:     "ru-central1-b",
+			PlatformId: "standard-v3",
+			Status:     compute.Instance_STOPPED,
+		},
+		{
+			Id:               "instance1",
+			FolderId:         "folder1",
+			Name:             "db",
+			ZoneId:           "ru-central1-a",
+			PlatformId:       "standard-v2",
+			Fqdn:             "db.ru-central1.internal",
+			Status:           compute.Instance_RUNNING,
+			ServiceAccountId: "sa1",
+			NetworkInterfaces: []*compute.NetworkInterface{
+				{
+					Index:      "0",
+					SubnetId:   "subnet1",
+					MacAddress: "d0:0d:11:22:33:44",
+					PrimaryV4Address: &compute.PrimaryAddress{
+						Address:     "192.168.0.10",
+						OneToOneNat: &compute.OneToOneNat{Address: "51.250.0.1"},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"instance_id":        "instance1",
+			"folder_id":          "folder1",
+			"name":               "db",
+			"description":        "",
+			"labels":             map[string]string(nil),
+			"zone":               "ru-central1-a",
+			"platform_id":        "standard-v2",
+			"fqdn":               "db.ru-central1.internal",
+			"status":             "running",
+			"service_account_id": "sa1",
+			"network_interface": []map[string]interface{}{
+				{
+					"index":          0,
+					"subnet_id":      "subnet1",
+					"mac_address":    "d0:0d:11:22:33:44",
+					"ip_address":     "192.168.0.10",
+					"ipv6_address":   "",
+					"nat_ip_address": "51.250.0.1",
+				},
+			},
+			"created_at": "",
+		},
+		{
+			"instance_id":        "instance2",
+			"folder_id":          "folder1",
+			"name":               "web",
+			"description":        "",
+			"labels":             map[string]string(nil),
+			"zone":               "ru-central1-b",
+			"platform_id":        "standard-v3",
+			"fqdn":               "",
+			"status":             "stopped",
+			"service_account_id": "",
+			"network_interface":  []map[string]interface{}{},
+			"created_at":         "",
+		},
+	}
+
+	actual, err := flattenComputeInstances(instances)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
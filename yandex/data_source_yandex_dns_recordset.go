@@ -0,0 +1,70 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func dataSourceYandexDnsRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexDnsRecordSetRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"data": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	zoneID := d.Get("zone_id").(string)
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+
+	rs, err := sdk.DNS().DnsZone().GetRecordSet(config.Context(), &dns.GetDnsZoneRecordSetRequest{
+		DnsZoneId: zoneID,
+		Name:      name,
+		Type:      recordType,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading DnsRecordSet %s %s: %s", recordType, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", zoneID, name, recordType))
+	d.Set("ttl", int(rs.Ttl))
+
+	return d.Set("data", convertStringArrToInterface(rs.Data))
+}
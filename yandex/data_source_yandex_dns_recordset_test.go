@@ -0,0 +1,39 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDNSRecordSet_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSRecordSetBasic(zoneName, fqdn) + dnsRecordSetDataConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAttrsCheck("data.yandex_dns_recordset.bar", "yandex_dns_recordset.rs1",
+						[]string{"zone_id", "name", "type", "ttl", "data"}),
+					resource.TestCheckResourceAttr("data.yandex_dns_recordset.bar", "data.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const dnsRecordSetDataConfig = `
+data "yandex_dns_recordset" "bar" {
+  zone_id = yandex_dns_recordset.rs1.zone_id
+  name    = yandex_dns_recordset.rs1.name
+  type    = yandex_dns_recordset.rs1.type
+}
+`
@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+func dataSourceYandexIAMServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexIAMServiceAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexIAMServiceAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting folder ID to list service accounts: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while listing service accounts: %s", err)
+	}
+
+	filter := d.Get("filter").(string)
+
+	var serviceAccounts []*iam.ServiceAccount
+	pageToken := ""
+	for {
+		resp, err := config.sdk.IAM().ServiceAccount().List(ctx, &iam.ListServiceAccountsRequest{
+			FolderId:  folderID,
+			Filter:    filter,
+			PageSize:  defaultListSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while getting list of service accounts in Folder %q: %s", folderID, err)
+		}
+		for _, sa := range resp.ServiceAccounts {
+			if labelsContain(sa.Labels, labels) {
+				serviceAccounts = append(serviceAccounts, sa)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if err := d.Set("service_accounts", flattenIAMServiceAccounts(serviceAccounts)); err != nil {
+		return err
+	}
+
+	d.Set("folder_id", folderID)
+	d.SetId(listDataSourceID(folderID, filter, labels))
+	return nil
+}
+
+// flattenIAMServiceAccounts returns service accounts ordered by name and ID, so that the list does not depend on API ordering.
+func flattenIAMServiceAccounts(serviceAccounts []*iam.ServiceAccount) []map[string]interface{} {
+	sort.SliceStable(serviceAccounts, func(i, j int) bool {
+		if serviceAccounts[i].Name != serviceAccounts[j].Name {
+			return serviceAccounts[i].Name < serviceAccounts[j].Name
+		}
+		return serviceAccounts[i].Id < serviceAccounts[j].Id
+	})
+
+	result := make([]map[string]interface{}, 0, len(serviceAccounts))
+	for _, sa := range serviceAccounts {
+		result = append(result, map[string]interface{}{
+			"service_account_id": sa.Id,
+			"folder_id":          sa.FolderId,
+			"name":               sa.Name,
+			"description":        sa.Description,
+			"labels":             sa.Labels,
+			"created_at":         getTimestamp(sa.CreatedAt),
+		})
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+func TestAccDataSourceYandexIAMServiceAccounts_byFilter(t *testing.T) {
+	accountName := "sa" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIAMServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataServiceAccountsByFilter(accountName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.list", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.list", "folder_id", getExampleFolderID()),
+					resource.TestCheckResourceAttrPair("data.yandex_iam_service_accounts.list", "service_accounts.0.service_account_id",
+						"yandex_iam_service_account.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.list", "service_accounts.0.name", accountName),
+					resource.TestCheckResourceAttr("data.yandex_iam_service_accounts.list", "service_accounts.0.description", "Service Account desc"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataServiceAccountsByFilter(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "foo" {
+  name        = "%[1]s"
+  description = "Service Account desc"
+}
+
+resource "yandex_iam_service_account" "bar" {
+  name = "%[1]s-other"
+}
+
+data "yandex_iam_service_accounts" "list" {
+  filter = "name=\"%[1]s\""
+
+  depends_on = [
+    yandex_iam_service_account.foo,
+    yandex_iam_service_account.bar,
+  ]
+}
+`, name)
+}
+
+func TestFlattenIAMServiceAccounts(t *testing.T) {
+	serviceAccounts := []*iam.ServiceAccount{
+		{Id: "sa2", FolderId: "folder1", Name: "deployer"},
+		{Id: "sa1", FolderId: "folder1", Name: "builder", Labels: map[string]string{"team": "ci"}},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"service_account_id": "sa1",
+			"folder_id":          "folder1",
+			"name":               "builder",
+			"description":        "",
+			"labels":             map[string]string{"team": "ci"},
+			"created_at":         "",
+		},
+		{
+			"service_account_id": "sa2",
+			"folder_id":          "folder1",
+			"name":               "deployer",
+			"description":        "",
+			"labels":             map[string]string(nil),
+			"created_at":         "",
+		},
+	}
+
+	if actual := flattenIAMServiceAccounts(serviceAccounts); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
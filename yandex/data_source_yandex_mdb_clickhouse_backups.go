@@ -0,0 +1,99 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+)
+
+func dataSourceYandexMDBClickHouseBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexMDBClickHouseBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_shard_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexMDBClickHouseBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+	clusterID := d.Get("cluster_id").(string)
+
+	var backups []*clickhouse.Backup
+	pageToken := ""
+	for {
+		resp, err := config.sdk.MDB().Clickhouse().Cluster().ListBackups(ctx, &clickhouse.ListClusterBackupsRequest{
+			ClusterId: clusterID,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while getting list of backups for ClickHouse Cluster %q: %s", clusterID, err)
+		}
+		backups = append(backups, resp.Backups...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if err := d.Set("backups", flattenClickHouseBackups(backups)); err != nil {
+		return err
+	}
+
+	d.SetId(clusterID)
+	return nil
+}
+
+func flattenClickHouseBackups(backups []*clickhouse.Backup) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(backups))
+	for _, b := range backups {
+		result = append(result, map[string]interface{}{
+			"id":                 b.Id,
+			"folder_id":          b.FolderId,
+			"source_cluster_id":  b.SourceClusterId,
+			"created_at":         getTimestamp(b.CreatedAt),
+			"started_at":         getTimestamp(b.StartedAt),
+			"source_shard_names": b.SourceShardNames,
+		})
+	}
+	return result
+}
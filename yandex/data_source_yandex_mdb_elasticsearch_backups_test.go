@@ -0,0 +1,43 @@
+package yandex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFlattenElasticsearchBackups(t *testing.T) {
+	backups := []*elasticsearch.Backup{
+		{
+			Id:                   "backup1",
+			FolderId:             "folder1",
+			SourceClusterId:      "cluster1",
+			CreatedAt:            &timestamppb.Timestamp{Seconds: 1656669600},
+			StartedAt:            &timestamppb.Timestamp{Seconds: 1656669000},
+			Indices:              []string{"index1", "index2"},
+			IndicesTotal:         2,
+			ElasticsearchVersion: "7.17.1",
+			SizeBytes:            2048,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                    "backup1",
+			"folder_id":             "folder1",
+			"source_cluster_id":     "cluster1",
+			"created_at":            getTimestamp(backups[0].CreatedAt),
+			"started_at":            getTimestamp(backups[0].StartedAt),
+			"indices":               []string{"index1", "index2"},
+			"indices_total":         int64(2),
+			"elasticsearch_version": "7.17.1",
+			"size":                  int64(2048),
+		},
+	}
+
+	if actual := flattenElasticsearchBackups(backups); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
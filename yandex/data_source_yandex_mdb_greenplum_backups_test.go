@@ -0,0 +1,37 @@
+package yandex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFlattenGreenplumBackups(t *testing.T) {
+	backups := []*greenplum.Backup{
+		{
+			Id:              "backup1",
+			FolderId:        "folder1",
+			SourceClusterId: "cluster1",
+			CreatedAt:       &timestamppb.Timestamp{Seconds: 1656669600},
+			StartedAt:       &timestamppb.Timestamp{Seconds: 1656669000},
+			Size:            1024,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                "backup1",
+			"folder_id":         "folder1",
+			"source_cluster_id": "cluster1",
+			"created_at":        getTimestamp(backups[0].CreatedAt),
+			"started_at":        getTimestamp(backups[0].StartedAt),
+			"size":              1024,
+		},
+	}
+
+	if actual := flattenGreenplumBackups(backups); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -223,6 +223,56 @@ func dataSourceYandexMDBGreenplumCluster() *schema.Resource {
 					},
 				},
 			},
+
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"day": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hour": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"greenplum_config": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"pooler_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pooling_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pool_client_idle_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -345,6 +395,26 @@ func dataSourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interf
 	}
 	d.Set("backup_window_start", []map[string]interface{}{bwsElement})
 
+	maintenanceWindow, err := flattenGreenplumMaintenanceWindow(cluster.MaintenanceWindow)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
+		return err
+	}
+
+	greenplumConfig, err := flattenGreenplumClusterConfig(cluster.ClusterConfig)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("greenplum_config", greenplumConfig); err != nil {
+		return err
+	}
+
+	if err := d.Set("pooler_config", flattenGreenplumPoolerConfig(cluster.GetClusterConfig().GetPool().GetUserConfig())); err != nil {
+		return err
+	}
+
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
 
 	d.SetId(cluster.Id)
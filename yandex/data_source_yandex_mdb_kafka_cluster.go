@@ -79,7 +79,7 @@ func dataSourceYandexMDBKafkaCluster() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Set:      kafkaUserHash,
-				Elem:     resourceYandexMDBKafkaUser(),
+				Elem:     resourceYandexMDBKafkaClusterUserBlock(),
 			},
 			"security_group_ids": {
 				Type:     schema.TypeSet,
@@ -0,0 +1,41 @@
+package yandex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFlattenMongodbBackups(t *testing.T) {
+	backups := []*mongodb.Backup{
+		{
+			Id:               "backup1",
+			FolderId:         "folder1",
+			SourceClusterId:  "cluster1",
+			CreatedAt:        &timestamppb.Timestamp{Seconds: 1656669600},
+			StartedAt:        &timestamppb.Timestamp{Seconds: 1656669000},
+			SourceShardNames: []string{"rs01"},
+			Size:             2048,
+			Type:             mongodb.Backup_MANUAL,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                 "backup1",
+			"folder_id":          "folder1",
+			"source_cluster_id":  "cluster1",
+			"created_at":         getTimestamp(backups[0].CreatedAt),
+			"started_at":         getTimestamp(backups[0].StartedAt),
+			"source_shard_names": []string{"rs01"},
+			"size":               2048,
+			"type":               "MANUAL",
+		},
+	}
+
+	if actual := flattenMongodbBackups(backups); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -195,6 +195,34 @@ func dataSourceYandexMDBRedisCluster() *schema.Resource {
 					},
 				},
 			},
+			"backup_window_start": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hours": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"access": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_lens": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"deletion_protection": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -296,6 +324,14 @@ func dataSourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if err := d.Set("backup_window_start", flattenRedisBackupWindowStart(cluster.Config.BackupWindowStart)); err != nil {
+		return err
+	}
+
+	if err := d.Set("access", flattenRedisAccess(cluster.Config.Access)); err != nil {
+		return err
+	}
+
 	if err := d.Set("host", hs); err != nil {
 		return err
 	}
@@ -0,0 +1,131 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+)
+
+func dataSourceYandexResourceManagerClouds() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexResourceManagerCloudsRead,
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"clouds": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexResourceManagerCloudsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while listing clouds: %s", err)
+	}
+
+	// clouds of all organizations available to the user are listed when organization_id is not set
+	organizationID := d.Get("organization_id").(string)
+	filter := d.Get("filter").(string)
+
+	var clouds []*resourcemanager.Cloud
+	pageToken := ""
+	for {
+		resp, err := config.sdk.ResourceManager().Cloud().List(ctx, &resourcemanager.ListCloudsRequest{
+			OrganizationId: organizationID,
+			Filter:         filter,
+			PageSize:       defaultListSize,
+			PageToken:      pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while getting list of clouds: %s", err)
+		}
+		for _, cloud := range resp.Clouds {
+			if labelsContain(cloud.Labels, labels) {
+				clouds = append(clouds, cloud)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if err := d.Set("clouds", flattenResourceManagerClouds(clouds)); err != nil {
+		return err
+	}
+
+	d.SetId(listDataSourceID(organizationID, filter, labels))
+	return nil
+}
+
+// flattenResourceManagerClouds returns clouds ordered by name and ID, so that the list does not depend on API ordering.
+func flattenResourceManagerClouds(clouds []*resourcemanager.Cloud) []map[string]interface{} {
+	sort.SliceStable(clouds, func(i, j int) bool {
+		if clouds[i].Name != clouds[j].Name {
+			return clouds[i].Name < clouds[j].Name
+		}
+		return clouds[i].Id < clouds[j].Id
+	})
+
+	result := make([]map[string]interface{}, 0, len(clouds))
+	for _, c := range clouds {
+		result = append(result, map[string]interface{}{
+			"cloud_id":        c.Id,
+			"organization_id": c.OrganizationId,
+			"name":            c.Name,
+			"description":     c.Description,
+			"labels":          c.Labels,
+			"created_at":      getTimestamp(c.CreatedAt),
+		})
+	}
+	return result
+}
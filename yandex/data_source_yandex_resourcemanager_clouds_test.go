@@ -0,0 +1,70 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+)
+
+func TestAccDataSourceYandexResourceManagerClouds_byFilter(t *testing.T) {
+	cloudID := getExampleCloudID()
+	cloudName := getExampleCloudName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceResourceManagerCloudsByFilter(cloudName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_clouds.list", "clouds.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_clouds.list", "clouds.0.cloud_id", cloudID),
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_clouds.list", "clouds.0.name", cloudName),
+					resource.TestCheckResourceAttrSet("data.yandex_resourcemanager_clouds.list", "clouds.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceResourceManagerCloudsByFilter(name string) string {
+	// language=tf
+	return fmt.Sprintf(`
+data "yandex_resourcemanager_clouds" "list" {
+  filter = "name=\"%s\""
+}
+`, name)
+}
+
+func TestFlattenResourceManagerClouds(t *testing.T) {
+	clouds := []*resourcemanager.Cloud{
+		{Id: "cloud2", OrganizationId: "org1", Name: "b"},
+		{Id: "cloud1", OrganizationId: "org1", Name: "a", Description: "first"},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"cloud_id":        "cloud1",
+			"organization_id": "org1",
+			"name":            "a",
+			"description":     "first",
+			"labels":          map[string]string(nil),
+			"created_at":      "",
+		},
+		{
+			"cloud_id":        "cloud2",
+			"organization_id": "org1",
+			"name":            "b",
+			"description":     "",
+			"labels":          map[string]string(nil),
+			"created_at":      "",
+		},
+	}
+
+	if actual := flattenResourceManagerClouds(clouds); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
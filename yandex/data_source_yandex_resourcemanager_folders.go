@@ -0,0 +1,142 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+)
+
+func dataSourceYandexResourceManagerFolders() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexResourceManagerFoldersRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"folders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexResourceManagerFoldersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	cloudID, err := getCloudID(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting cloud ID to list folders: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while listing folders: %s", err)
+	}
+
+	filter := d.Get("filter").(string)
+
+	var folders []*resourcemanager.Folder
+	pageToken := ""
+	for {
+		resp, err := config.sdk.ResourceManager().Folder().List(ctx, &resourcemanager.ListFoldersRequest{
+			CloudId:   cloudID,
+			Filter:    filter,
+			PageSize:  defaultListSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while getting list of folders in Cloud %q: %s", cloudID, err)
+		}
+		for _, folder := range resp.Folders {
+			if labelsContain(folder.Labels, labels) {
+				folders = append(folders, folder)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if err := d.Set("folders", flattenResourceManagerFolders(folders)); err != nil {
+		return err
+	}
+
+	d.Set("cloud_id", cloudID)
+	d.SetId(listDataSourceID(cloudID, filter, labels))
+	return nil
+}
+
+// flattenResourceManagerFolders returns folders ordered by name and ID, so that the list does not depend on API ordering.
+func flattenResourceManagerFolders(folders []*resourcemanager.Folder) []map[string]interface{} {
+	sort.SliceStable(folders, func(i, j int) bool {
+		if folders[i].Name != folders[j].Name {
+			return folders[i].Name < folders[j].Name
+		}
+		return folders[i].Id < folders[j].Id
+	})
+
+	result := make([]map[string]interface{}, 0, len(folders))
+	for _, f := range folders {
+		result = append(result, map[string]interface{}{
+			"folder_id":   f.Id,
+			"cloud_id":    f.CloudId,
+			"name":        f.Name,
+			"description": f.Description,
+			"labels":      f.Labels,
+			"status":      strings.ToLower(f.Status.String()),
+			"created_at":  getTimestamp(f.CreatedAt),
+		})
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+)
+
+func TestAccDataSourceYandexResourceManagerFolders_byLabels(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-folders")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceResourceManagerFoldersByLabels(label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_folders.list", "folders.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_folders.list", "cloud_id", getExampleCloudID()),
+					resource.TestCheckResourceAttrPair("data.yandex_resourcemanager_folders.list", "folders.0.folder_id",
+						"yandex_resourcemanager_folder.a", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_resourcemanager_folders.list", "folders.1.folder_id",
+						"yandex_resourcemanager_folder.b", "id"),
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_folders.list", "folders.0.status", "active"),
+					resource.TestCheckResourceAttr("data.yandex_resourcemanager_folders.list", "folders.0.labels.tf-test", label),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceResourceManagerFoldersByLabels(label string) string {
+	// language=tf
+	return fmt.Sprintf(`
+resource "yandex_resourcemanager_folder" "b" {
+  name = "%[1]s-b"
+  labels = {
+    tf-test = "%[1]s"
+  }
+}
+
+resource "yandex_resourcemanager_folder" "a" {
+  name = "%[1]s-a"
+  labels = {
+    tf-test = "%[1]s"
+  }
+}
+
+data "yandex_resourcemanager_folders" "list" {
+  labels = {
+    tf-test = "%[1]s"
+  }
+
+  depends_on = [
+    yandex_resourcemanager_folder.a,
+    yandex_resourcemanager_folder.b,
+  ]
+}
+`, label)
+}
+
+func TestFlattenResourceManagerFolders(t *testing.T) {
+	folders := []*resourcemanager.Folder{
+		{Id: "folder3", CloudId: "cloud1", Name: "prod", Status: resourcemanager.Folder_ACTIVE},
+		{Id: "folder2", CloudId: "cloud1", Name: "dev", Status: resourcemanager.Folder_PENDING_DELETION},
+		{Id: "folder1", CloudId: "cloud1", Name: "prod", Labels: map[string]string{"env": "prod"}, Status: resourcemanager.Folder_ACTIVE},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"folder_id":   "folder2",
+			"cloud_id":    "cloud1",
+			"name":        "dev",
+			"description": "",
+			"labels":      map[string]string(nil),
+			"status":      "pending_deletion",
+			"created_at":  "",
+		},
+		{
+			"folder_id":   "folder1",
+			"cloud_id":    "cloud1",
+			"name":        "prod",
+			"description": "",
+			"labels":      map[string]string{"env": "prod"},
+			"status":      "active",
+			"created_at":  "",
+		},
+		{
+			"folder_id":   "folder3",
+			"cloud_id":    "cloud1",
+			"name":        "prod",
+			"description": "",
+			"labels":      map[string]string(nil),
+			"status":      "active",
+			"created_at":  "",
+		},
+	}
+
+	if actual := flattenResourceManagerFolders(folders); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -148,7 +148,7 @@ func dataSourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if err := yandexVPCSecurityGroupRead(d, meta, sgID); err != nil {
+	if err := yandexVPCSecurityGroupRead(d, meta, sgID, false); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -83,6 +84,10 @@ func dataSourceYandexVPCSecurityGroupRuleRead(data *schema.ResourceData, meta in
 		return err
 	}
 
+	if rule == nil {
+		return fmt.Errorf("couldn't find rule %s in security group %s", ruleId, sgId)
+	}
+
 	data.SetId(ruleId)
 
 	return writeSecurityGroupRuleToData(rule, data)
@@ -3,8 +3,21 @@ package yandex
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const (
+	defaultIAMPropagationTimeout = time.Minute
+	// Access bindings are read from replicas, so a change should be seen several times in a row
+	// before it is considered propagated.
+	iamPropagationConsistentReads = 3
+	iamPropagationPollInterval    = time.Second
 )
 
 type ResourceIamUpdater interface {
@@ -62,3 +75,76 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 
 	return nil
 }
+
+type iamPolicyCheckFunc func(p *Policy) bool
+
+// iamWaitPolicyPropagation polls access bindings of the resource until check passes on
+// iamPropagationConsistentReads consecutive reads. It does nothing unless waiting for IAM propagation
+// is enabled in the provider configuration.
+func iamWaitPolicyPropagation(config *Config, updater ResourceIamUpdater, check iamPolicyCheckFunc) error {
+	if !config.IAMWaitPropagation {
+		return nil
+	}
+
+	log.Printf("[DEBUG]: Waiting for IAM policy change of %s to propagate", updater.DescribeResource())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"propagated"},
+		Refresh: func() (interface{}, string, error) {
+			p, err := updater.GetResourceIamPolicy()
+			if err != nil {
+				return nil, "", err
+			}
+			if !check(p) {
+				return p, "pending", nil
+			}
+			return p, "propagated", nil
+		},
+		Timeout:                   config.IAMPropagationTimeout,
+		PollInterval:              iamPropagationPollInterval,
+		ContinuousTargetOccurence: iamPropagationConsistentReads,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for IAM policy change of %s to propagate: %s", updater.DescribeResource(), err)
+	}
+
+	log.Printf("[DEBUG]: IAM policy change of %s propagated", updater.DescribeResource())
+	return nil
+}
+
+// iamPolicyHasBindings returns a check that passes when all of the bindings are present in the policy.
+func iamPolicyHasBindings(bindings []*access.AccessBinding) iamPolicyCheckFunc {
+	return func(p *Policy) bool {
+		bm := rolesToMembersMap(p.Bindings)
+		for _, b := range bindings {
+			if !bm[b.RoleId][canonicalMember(b)] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// iamPolicyHasExactRoleBindings returns a check that passes when the role is bound to the given bindings only.
+func iamPolicyHasExactRoleBindings(role string, bindings []*access.AccessBinding) iamPolicyCheckFunc {
+	return func(p *Policy) bool {
+		members := rolesToMembersMap(p.Bindings)[role]
+		return len(members) == len(rolesToMembersMap(bindings)[role]) && iamPolicyHasBindings(bindings)(p)
+	}
+}
+
+// iamPolicyHasExactBindings returns a check that passes when the policy consists of the given bindings only.
+func iamPolicyHasExactBindings(bindings []*access.AccessBinding) iamPolicyCheckFunc {
+	return func(p *Policy) bool {
+		return reflect.DeepEqual(rolesToMembersMap(p.Bindings), rolesToMembersMap(bindings))
+	}
+}
+
+// iamPolicyHasNoBinding returns a check that passes when the member is not bound to the role.
+func iamPolicyHasNoBinding(binding *access.AccessBinding) iamPolicyCheckFunc {
+	return func(p *Policy) bool {
+		return !rolesToMembersMap(p.Bindings)[binding.RoleId][canonicalMember(binding)]
+	}
+}
@@ -0,0 +1,107 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMApiGatewayDefaultTimeout = 1 * time.Minute
+
+var IamApiGatewaySchema = map[string]*schema.Schema{
+	"api_gateway_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ApiGatewayIamUpdater struct {
+	apiGatewayID string
+	Config       *Config
+}
+
+func newApiGatewayIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ApiGatewayIamUpdater{
+		apiGatewayID: d.Get("api_gateway_id").(string),
+		Config:       config,
+	}, nil
+}
+
+func apiGatewayIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("api_gateway_id", d.Id())
+	return nil
+}
+
+func (u *ApiGatewayIamUpdater) GetResourceIamPolicy() (*Policy, error) {
+	bindings, err := getApiGatewayAccessBindings(u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *ApiGatewayIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.apiGatewayID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMApiGatewayDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Serverless().APIGateway().ApiGateway().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *ApiGatewayIamUpdater) GetResourceID() string {
+	return u.apiGatewayID
+}
+
+func (u *ApiGatewayIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-api-gateway-%s", u.apiGatewayID)
+}
+
+func (u *ApiGatewayIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("API gateway '%s'", u.apiGatewayID)
+}
+
+func getApiGatewayAccessBindings(config *Config, apiGatewayID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+	ctx := config.Context()
+
+	for {
+		resp, err := config.sdk.Serverless().APIGateway().ApiGateway().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: apiGatewayID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for API gateway %s: %s", apiGatewayID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
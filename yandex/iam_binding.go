@@ -28,9 +28,10 @@ var accessBindingSchema = map[string]*schema.Schema{
 	},
 	// for test purposes, to compensate IAM operations delay
 	"sleep_after": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		ForceNew: false,
+		Type:       schema.TypeInt,
+		Optional:   true,
+		ForceNew:   false,
+		Deprecated: iamSleepAfterDeprecationMessage,
 	},
 }
 
@@ -76,6 +77,11 @@ func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 		role := p[0].RoleId
 		d.SetId(updater.GetResourceID() + "/" + role)
 
+		err = iamWaitPolicyPropagation(config, updater, iamPolicyHasBindings(p))
+		if err != nil {
+			return err
+		}
+
 		if v, ok := d.GetOk("sleep_after"); ok {
 			time.Sleep(time.Second * time.Duration(v.(int)))
 		}
@@ -153,6 +159,11 @@ func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return err
 		}
 
+		err = iamWaitPolicyPropagation(config, updater, iamPolicyHasExactRoleBindings(role, bindings))
+		if err != nil {
+			return err
+		}
+
 		return resourceAccessBindingRead(newUpdaterFunc, true)(d, meta)
 	}
 }
@@ -181,6 +192,11 @@ func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return err
 		}
 
+		err = iamWaitPolicyPropagation(config, updater, iamPolicyHasExactRoleBindings(role, nil))
+		if err != nil {
+			return err
+		}
+
 		return resourceAccessBindingRead(newUpdaterFunc, false)(d, meta)
 	}
 }
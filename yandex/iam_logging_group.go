@@ -0,0 +1,107 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMLoggingGroupDefaultTimeout = 1 * time.Minute
+
+var IamLoggingGroupSchema = map[string]*schema.Schema{
+	"log_group_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type LoggingGroupIamUpdater struct {
+	logGroupID string
+	Config     *Config
+}
+
+func newLoggingGroupIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &LoggingGroupIamUpdater{
+		logGroupID: d.Get("log_group_id").(string),
+		Config:     config,
+	}, nil
+}
+
+func loggingGroupIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("log_group_id", d.Id())
+	return nil
+}
+
+func (u *LoggingGroupIamUpdater) GetResourceIamPolicy() (*Policy, error) {
+	bindings, err := getLoggingGroupAccessBindings(u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *LoggingGroupIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.logGroupID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMLoggingGroupDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Logging().LogGroup().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *LoggingGroupIamUpdater) GetResourceID() string {
+	return u.logGroupID
+}
+
+func (u *LoggingGroupIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-logging-group-%s", u.logGroupID)
+}
+
+func (u *LoggingGroupIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("log group '%s'", u.logGroupID)
+}
+
+func getLoggingGroupAccessBindings(config *Config, logGroupID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+	ctx := config.Context()
+
+	for {
+		resp, err := config.sdk.Logging().LogGroup().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: logGroupID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for log group %s: %s", logGroupID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
@@ -27,17 +27,31 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 	},
 	// for test purposes, to compensate IAM operations delay
 	"sleep_after": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		ForceNew: true,
+		Type:       schema.TypeInt,
+		Optional:   true,
+		ForceNew:   true,
+		Deprecated: iamSleepAfterDeprecationMessage,
 	},
 }
 
+const iamSleepAfterDeprecationMessage = "The 'sleep_after' field has been deprecated. " +
+	"Enable 'iam_wait_propagation' in the provider configuration to wait until IAM changes are visible."
+
+// Subject types accepted by access bindings, see https://cloud.yandex.com/docs/iam/concepts/access-control/#subject
+var iamMemberTypes = []string{"userAccount", "serviceAccount", "federatedUser", "group", "system"}
+
 func validateIamMember(i interface{}, k string) (s []string, es []error) {
 	chunks := strings.SplitN(i.(string), ":", 2)
 	if len(chunks) == 1 || chunks[0] == "" || chunks[1] == "" {
 		es = append(es, fmt.Errorf("expect 'member' value should be in TYPE:ID format, got '%v'", i.(string)))
+		return
 	}
+	for _, memberType := range iamMemberTypes {
+		if chunks[0] == memberType {
+			return
+		}
+	}
+	es = append(es, fmt.Errorf("expect 'member' type to be one of %v, got '%v'", iamMemberTypes, chunks[0]))
 	return
 }
 
@@ -122,6 +136,11 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		}
 		d.SetId(updater.GetResourceID() + "/" + p.RoleId + "/" + canonicalMember(p))
 
+		err = iamWaitPolicyPropagation(config, updater, iamPolicyHasBindings([]*access.AccessBinding{p}))
+		if err != nil {
+			return err
+		}
+
 		if v, ok := d.GetOk("sleep_after"); ok {
 			time.Sleep(time.Second * time.Duration(v.(int)))
 		}
@@ -227,6 +246,11 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 			return err
 		}
 
+		err = iamWaitPolicyPropagation(config, updater, iamPolicyHasNoBinding(member))
+		if err != nil {
+			return err
+		}
+
 		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
 	}
 }
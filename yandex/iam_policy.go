@@ -60,7 +60,7 @@ func resourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			return err
 		}
 
-		if err := setIamPolicyData(d, updater); err != nil {
+		if err := setIamPolicyData(d, config, updater); err != nil {
 			return err
 		}
 
@@ -102,7 +102,7 @@ func resourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Up
 		}
 
 		if d.HasChange("policy_data") {
-			if err := setIamPolicyData(d, updater); err != nil {
+			if err := setIamPolicyData(d, config, updater); err != nil {
 				return err
 			}
 		}
@@ -121,18 +121,26 @@ func resourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 
 		// Set an empty policy to delete the attached policy.
 		err = updater.SetResourceIamPolicy(&Policy{})
-		return err
+		if err != nil {
+			return err
+		}
+
+		return iamWaitPolicyPropagation(config, updater, iamPolicyHasExactBindings(nil))
 	}
 }
 
-func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error {
+func setIamPolicyData(d *schema.ResourceData, config *Config, updater ResourceIamUpdater) error {
 	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
 	if err != nil {
 		return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
 	}
 
 	err = updater.SetResourceIamPolicy(policy)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return iamWaitPolicyPropagation(config, updater, iamPolicyHasExactBindings(policy.Bindings))
 }
 
 func marshalIamPolicy(policy *Policy) string {
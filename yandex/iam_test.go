@@ -0,0 +1,129 @@
+package yandex
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+// fakeIamUpdater returns the policies one by one on each read, repeating the last one.
+type fakeIamUpdater struct {
+	policies []*Policy
+	reads    int
+}
+
+func (u *fakeIamUpdater) GetResourceIamPolicy() (*Policy, error) {
+	idx := u.reads
+	if idx >= len(u.policies) {
+		idx = len(u.policies) - 1
+	}
+	u.reads++
+	return u.policies[idx], nil
+}
+
+func (u *fakeIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (u *fakeIamUpdater) GetMutexKey() string {
+	return "iam-fake-id"
+}
+
+func (u *fakeIamUpdater) GetResourceID() string {
+	return "id"
+}
+
+func (u *fakeIamUpdater) DescribeResource() string {
+	return "fake resource \"id\""
+}
+
+func TestIamPolicyChecks(t *testing.T) {
+	viewer := roleMemberToAccessBinding("viewer", "userAccount:user1")
+	otherViewer := roleMemberToAccessBinding("viewer", "group:group1")
+	editor := roleMemberToAccessBinding("editor", "userAccount:user1")
+	p := &Policy{Bindings: []*access.AccessBinding{viewer, editor}}
+
+	assert.True(t, iamPolicyHasBindings([]*access.AccessBinding{viewer, editor})(p))
+	assert.False(t, iamPolicyHasBindings([]*access.AccessBinding{viewer, otherViewer})(p))
+
+	assert.True(t, iamPolicyHasExactRoleBindings("viewer", []*access.AccessBinding{viewer})(p))
+	assert.False(t, iamPolicyHasExactRoleBindings("viewer", []*access.AccessBinding{viewer, otherViewer})(p))
+	assert.False(t, iamPolicyHasExactRoleBindings("viewer", nil)(p))
+	assert.True(t, iamPolicyHasExactRoleBindings("admin", nil)(p))
+
+	assert.False(t, iamPolicyHasNoBinding(viewer)(p))
+	assert.True(t, iamPolicyHasNoBinding(otherViewer)(p))
+
+	assert.True(t, iamPolicyHasExactBindings([]*access.AccessBinding{editor, viewer})(p))
+	assert.False(t, iamPolicyHasExactBindings([]*access.AccessBinding{viewer})(p))
+	assert.False(t, iamPolicyHasExactBindings(nil)(p))
+	assert.True(t, iamPolicyHasExactBindings(nil)(&Policy{}))
+}
+
+// Every resource with IAM bindings should have member and policy variants that support import.
+func TestIamResourcesVariants(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name := range resources {
+		if !strings.HasSuffix(name, "_iam_binding") {
+			continue
+		}
+		prefix := strings.TrimSuffix(name, "_iam_binding")
+		for _, kind := range []string{"_iam_binding", "_iam_member", "_iam_policy"} {
+			r, ok := resources[prefix+kind]
+			if assert.True(t, ok, "resource %s%s is not registered", prefix, kind) {
+				assert.NotNil(t, r.Importer, "resource %s%s does not support import", prefix, kind)
+			}
+		}
+	}
+}
+
+func TestIamMemberImport(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if !strings.HasSuffix(name, "_iam_member") {
+			continue
+		}
+
+		d := r.TestResourceData()
+		d.SetId("some_resource_id viewer group:some_group_id")
+
+		states, err := r.Importer.State(d, &Config{})
+		if !assert.NoError(t, err, "import of %s", name) {
+			continue
+		}
+
+		state := states[0]
+		assert.Equal(t, "some_resource_id/viewer/group:some_group_id", state.Id(), "import of %s", name)
+		assert.Equal(t, "viewer", state.Get("role"), "import of %s", name)
+		assert.Equal(t, "group:some_group_id", state.Get("member"), "import of %s", name)
+	}
+}
+
+func TestIamWaitPolicyPropagation(t *testing.T) {
+	binding := roleMemberToAccessBinding("viewer", "userAccount:user1")
+	empty := &Policy{}
+	bound := &Policy{Bindings: []*access.AccessBinding{binding}}
+	check := iamPolicyHasBindings([]*access.AccessBinding{binding})
+
+	disabled := &fakeIamUpdater{policies: []*Policy{empty}}
+	err := iamWaitPolicyPropagation(&Config{}, disabled, check)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, disabled.reads)
+
+	config := &Config{IAMWaitPropagation: true, IAMPropagationTimeout: 30 * time.Second}
+
+	// a stale read resets the number of consistent reads
+	flapping := &fakeIamUpdater{policies: []*Policy{empty, bound, empty, bound}}
+	err = iamWaitPolicyPropagation(config, flapping, check)
+	assert.NoError(t, err)
+	assert.Equal(t, 3+iamPropagationConsistentReads, flapping.reads)
+
+	config.IAMPropagationTimeout = time.Second
+	never := &fakeIamUpdater{policies: []*Policy{empty}}
+	err = iamWaitPolicyPropagation(config, never, check)
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+// impersonatedTokenRefreshMargin is how long before the actual expiration an impersonated IAM token is renewed,
+// so that a token taken from the SDK cache does not expire in the middle of a long operation.
+const impersonatedTokenRefreshMargin = 5 * time.Minute
+
+type iamTokenForServiceAccountFunc func(ctx context.Context, serviceAccountID string) (*iam.CreateIamTokenResponse, error)
+
+// impersonatedServiceAccountCredentials mints short-lived IAM tokens of the target service account
+// on behalf of the subject of the provider credentials.
+type impersonatedServiceAccountCredentials struct {
+	serviceAccountID string
+	createToken      iamTokenForServiceAccountFunc
+	now              func() time.Time
+}
+
+func newImpersonatedServiceAccountCredentials(serviceAccountID string, createToken iamTokenForServiceAccountFunc) *impersonatedServiceAccountCredentials {
+	return &impersonatedServiceAccountCredentials{
+		serviceAccountID: serviceAccountID,
+		createToken:      createToken,
+		now:              time.Now,
+	}
+}
+
+func (c *impersonatedServiceAccountCredentials) YandexCloudAPICredentials() {}
+
+func (c *impersonatedServiceAccountCredentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	token, err := c.createToken(ctx, c.serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating IAM token for impersonated service account %q: %s", c.serviceAccountID, err)
+	}
+
+	return &iam.CreateIamTokenResponse{
+		IamToken:  token.IamToken,
+		ExpiresAt: timestamppb.New(c.refreshAt(token.ExpiresAt.AsTime())),
+	}, nil
+}
+
+// refreshAt returns the time to renew a token that expires at expiresAt: the refresh margin before the expiration,
+// or the middle of the remaining lifetime for tokens that live shorter than two margins.
+func (c *impersonatedServiceAccountCredentials) refreshAt(expiresAt time.Time) time.Time {
+	now := c.now()
+	lifetime := expiresAt.Sub(now)
+	if lifetime < 2*impersonatedTokenRefreshMargin {
+		return now.Add(lifetime / 2)
+	}
+	return expiresAt.Add(-impersonatedTokenRefreshMargin)
+}
@@ -0,0 +1,118 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+func TestImpersonatedServiceAccountCredentials(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	lifetime := 12 * time.Hour
+
+	var requested []string
+	creds := newImpersonatedServiceAccountCredentials("sa-id", func(ctx context.Context, serviceAccountID string) (*iam.CreateIamTokenResponse, error) {
+		requested = append(requested, serviceAccountID)
+		if lifetime <= 0 {
+			return nil, fmt.Errorf("permission denied")
+		}
+		return &iam.CreateIamTokenResponse{
+			IamToken:  "t1.impersonated",
+			ExpiresAt: timestamppb.New(now.Add(lifetime)),
+		}, nil
+	})
+	creds.now = func() time.Time { return now }
+
+	token, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.impersonated", token.IamToken)
+	assert.Equal(t, now.Add(lifetime-impersonatedTokenRefreshMargin), token.ExpiresAt.AsTime())
+
+	// short-lived tokens are renewed in the middle of their lifetime
+	lifetime = 4 * time.Minute
+	token, err = creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(2*time.Minute), token.ExpiresAt.AsTime())
+
+	lifetime = 0
+	_, err = creds.IAMToken(context.Background())
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"sa-id", "sa-id", "sa-id"}, requested)
+}
+
+func TestConfigImpersonateServiceAccount(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	l := localListener(t)
+
+	endpoint.RegisterApiEndpointServiceServer(grpcServer, &mockIamAPIEndpointServer{addr: l.Addr().String()})
+	iamTokenServer := &mockIamTokenServer{}
+	iam.RegisterIamTokenServiceServer(grpcServer, iamTokenServer)
+
+	go func() { _ = grpcServer.Serve(l) }()
+	defer grpcServer.Stop()
+
+	config := Config{
+		Endpoint:                    l.Addr().String(),
+		FolderID:                    testConfigFolder,
+		Token:                       "t1.provider.token",
+		ImpersonateServiceAccountID: "sa-id",
+		Insecure:                    true,
+		Plaintext:                   true,
+	}
+
+	err := config.initAndValidate(context.Background(), testTerraformVersion, false)
+	require.NoError(t, err)
+
+	token, err := config.sdk.CreateIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.sa-id.token", token.IamToken)
+	assert.Equal(t, "sa-id", iamTokenServer.serviceAccountID)
+	assert.Equal(t, "Bearer t1.provider.token", iamTokenServer.authorization)
+}
+
+// mockIamAPIEndpointServer points the SDK to the same server for the IAM service
+type mockIamAPIEndpointServer struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	addr string
+}
+
+func (s *mockIamAPIEndpointServer) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	return &endpoint.ListApiEndpointsResponse{
+		Endpoints: []*endpoint.ApiEndpoint{
+			{
+				Id:      "iam",
+				Address: s.addr,
+			},
+		},
+	}, nil
+}
+
+type mockIamTokenServer struct {
+	iam.UnimplementedIamTokenServiceServer
+	serviceAccountID string
+	authorization    string
+}
+
+func (s *mockIamTokenServer) CreateForServiceAccount(ctx context.Context, r *iam.CreateIamTokenForServiceAccountRequest) (*iam.CreateIamTokenResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if authorization := md.Get("authorization"); len(authorization) > 0 {
+		s.authorization = authorization[0]
+	}
+	s.serviceAccountID = r.ServiceAccountId
+
+	return &iam.CreateIamTokenResponse{
+		IamToken:  "t1." + r.ServiceAccountId + ".token",
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+	}, nil
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 )
 
-//go:generate ../scripts/mockgen.sh KafkaTopicModifier
+//go:generate ../scripts/mockgen.sh KafkaTopicModifier,KafkaUserModifier
 
 type KafkaTopicModifier interface {
 	CreateKafkaTopic(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec) error
@@ -33,3 +33,34 @@ func (tm *KafkaTopicManager) DeleteKafkaTopic(ctx context.Context, d *schema.Res
 func (tm *KafkaTopicManager) UpdateKafkaTopic(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec, paths []string) error {
 	return updateKafkaTopic(ctx, tm.Config, d, topicSpec, paths)
 }
+
+type KafkaUserModifier interface {
+	CreateKafkaUser(ctx context.Context, d *schema.ResourceData, userSpec *kafka.UserSpec) error
+	DeleteKafkaUser(ctx context.Context, d *schema.ResourceData, userName string) error
+	ListKafkaUsers(ctx context.Context, d *schema.ResourceData) ([]*kafka.User, error)
+	UpdateKafkaUser(ctx context.Context, d *schema.ResourceData, req *kafka.UpdateUserRequest) error
+}
+
+type KafkaUserManager struct {
+	Config *Config
+}
+
+func NewKafkaUserManager(config *Config) *KafkaUserManager {
+	return &KafkaUserManager{Config: config}
+}
+
+func (um *KafkaUserManager) CreateKafkaUser(ctx context.Context, d *schema.ResourceData, userSpec *kafka.UserSpec) error {
+	return createKafkaUser(ctx, um.Config, d, userSpec)
+}
+
+func (um *KafkaUserManager) DeleteKafkaUser(ctx context.Context, d *schema.ResourceData, userName string) error {
+	return deleteKafkaUser(ctx, um.Config, d, userName)
+}
+
+func (um *KafkaUserManager) ListKafkaUsers(ctx context.Context, d *schema.ResourceData) ([]*kafka.User, error) {
+	return listKafkaUsers(ctx, um.Config, d.Id())
+}
+
+func (um *KafkaUserManager) UpdateKafkaUser(ctx context.Context, d *schema.ResourceData, req *kafka.UpdateUserRequest) error {
+	return updateKafkaUser(ctx, um.Config, d, req)
+}
@@ -51,6 +51,98 @@ func sortClickHouseHosts(hosts []*clickhouse.Host, specs []*clickhouse.HostSpec)
 	return hosts
 }
 
+// Returns names of the shards declared in the `host` list of the cluster resource.
+// Hosts without explicit shard name belong to the default "shard1".
+func clickHouseDeclaredShardNames(hosts []interface{}) map[string]bool {
+	shards := map[string]bool{}
+	for _, v := range hosts {
+		h := v.(map[string]interface{})
+		if h["type"] != clickhouse.Host_CLICKHOUSE.String() {
+			continue
+		}
+		shardName, _ := h["shard_name"].(string)
+		if shardName == "" {
+			shardName = "shard1"
+		}
+		shards[shardName] = true
+	}
+	return shards
+}
+
+// Removes hosts of the shards that are not declared in the cluster resource,
+// e.g. managed by a standalone `yandex_mdb_clickhouse_shard` resource.
+// ZooKeeper hosts are always kept. Nothing is filtered when no shards are declared (i.e. on import).
+func filterClickHouseHostsByShards(hosts []*clickhouse.Host, shards map[string]bool) []*clickhouse.Host {
+	if len(shards) == 0 {
+		return hosts
+	}
+
+	var result []*clickhouse.Host
+	for _, h := range hosts {
+		if h.Type == clickhouse.Host_ZOOKEEPER || shards[h.ShardName] {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// Removes shard groups which are not listed in `names`, e.g. managed by
+// a standalone `yandex_mdb_clickhouse_shard_group` resource. Nil `names` keeps all groups.
+func filterClickHouseShardGroups(groups []*clickhouse.ShardGroup, names map[string]bool) []*clickhouse.ShardGroup {
+	if names == nil {
+		return groups
+	}
+
+	var result []*clickhouse.ShardGroup
+	for _, g := range groups {
+		if names[g.Name] {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// Removes format schemas which are not listed in `names`, e.g. managed by
+// a standalone `yandex_mdb_clickhouse_format_schema` resource. Nil `names` keeps all schemas.
+func filterClickHouseFormatSchemas(schemas []*clickhouse.FormatSchema, names map[string]bool) []*clickhouse.FormatSchema {
+	if names == nil {
+		return schemas
+	}
+
+	var result []*clickhouse.FormatSchema
+	for _, s := range schemas {
+		if names[s.Name] {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// Removes ml models which are not listed in `names`, e.g. managed by
+// a standalone `yandex_mdb_clickhouse_ml_model` resource. Nil `names` keeps all models.
+func filterClickHouseMlModels(models []*clickhouse.MlModel, names map[string]bool) []*clickhouse.MlModel {
+	if names == nil {
+		return models
+	}
+
+	var result []*clickhouse.MlModel
+	for _, m := range models {
+		if names[m.Name] {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// Returns values of the `name` attribute of the given blocks.
+func clickHouseDeclaredNames(blocks []interface{}) map[string]bool {
+	names := map[string]bool{}
+	for _, v := range blocks {
+		names[v.(map[string]interface{})["name"].(string)] = true
+	}
+	return names
+}
+
 func clickHouseUserPermissionHash(v interface{}) int {
 	m := v.(map[string]interface{})
 
@@ -1989,6 +2081,77 @@ func flattenClickHouseHosts(hs []*clickhouse.Host) ([]map[string]interface{}, er
 	return res, nil
 }
 
+func expandClickHouseShardHosts(d *schema.ResourceData) []*clickhouse.HostSpec {
+	var result []*clickhouse.HostSpec
+	shardName := d.Get("name").(string)
+
+	for _, v := range d.Get("host").([]interface{}) {
+		h := v.(map[string]interface{})
+		result = append(result, &clickhouse.HostSpec{
+			ZoneId:         h["zone"].(string),
+			Type:           clickhouse.Host_CLICKHOUSE,
+			SubnetId:       h["subnet_id"].(string),
+			AssignPublicIp: h["assign_public_ip"].(bool),
+			ShardName:      shardName,
+		})
+	}
+
+	return result
+}
+
+// Keeps hosts tracked by the `host` list of the shard resource so that hosts added with
+// `yandex_mdb_clickhouse_host` are ignored. Entries with a known FQDN match it exactly,
+// entries without one (just created or added) claim any remaining host in the same zone.
+// Nothing is filtered when the list is empty (i.e. on import).
+func filterClickHouseShardHosts(hosts []*clickhouse.Host, declared []interface{}) []*clickhouse.Host {
+	if len(declared) == 0 {
+		return hosts
+	}
+
+	claimed := map[string]bool{}
+	var pendingZones []string
+	for _, v := range declared {
+		h := v.(map[string]interface{})
+		if fqdn, _ := h["fqdn"].(string); fqdn != "" {
+			claimed[fqdn] = true
+		} else {
+			pendingZones = append(pendingZones, h["zone"].(string))
+		}
+	}
+
+	for _, zone := range pendingZones {
+		for _, h := range hosts {
+			if !claimed[h.Name] && h.ZoneId == zone {
+				claimed[h.Name] = true
+				break
+			}
+		}
+	}
+
+	var result []*clickhouse.Host
+	for _, h := range hosts {
+		if claimed[h.Name] {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+func flattenClickHouseShardHosts(hs []*clickhouse.Host) []map[string]interface{} {
+	res := []map[string]interface{}{}
+
+	for _, h := range hs {
+		m := map[string]interface{}{}
+		m["zone"] = h.ZoneId
+		m["subnet_id"] = h.SubnetId
+		m["assign_public_ip"] = h.AssignPublicIp
+		m["fqdn"] = h.Name
+		res = append(res, m)
+	}
+
+	return res
+}
+
 func expandClickHouseShardGroups(d *schema.ResourceData) ([]*clickhouse.ShardGroup, error) {
 	var result []*clickhouse.ShardGroup
 	groups := d.Get("shard_group").([]interface{})
@@ -2128,3 +2291,306 @@ func flattenClickHouseMlModels(schemas []*clickhouse.MlModel) ([]map[string]inte
 
 	return res, nil
 }
+
+func expandClickHouseDictionary(d *schema.ResourceData) (*clickhouseConfig.ClickhouseConfig_ExternalDictionary, error) {
+	dictionary := &clickhouseConfig.ClickhouseConfig_ExternalDictionary{
+		Name:      d.Get("name").(string),
+		Structure: expandClickHouseDictionaryStructure(d.Get("structure.0").(map[string]interface{})),
+	}
+
+	layout := d.Get("layout.0").(map[string]interface{})
+	layoutType, err := expandEnum("type", layout["type"].(string), clickhouseConfig.ClickhouseConfig_ExternalDictionary_Layout_Type_value)
+	if err != nil {
+		return nil, err
+	}
+	dictionary.Layout = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_Layout{
+		Type:        clickhouseConfig.ClickhouseConfig_ExternalDictionary_Layout_Type(*layoutType),
+		SizeInCells: int64(layout["size_in_cells"].(int)),
+	}
+
+	if v, ok := d.GetOk("fixed_lifetime"); ok {
+		dictionary.Lifetime = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_FixedLifetime{
+			FixedLifetime: int64(v.(int)),
+		}
+	}
+	if _, ok := d.GetOk("lifetime_range"); ok {
+		dictionary.Lifetime = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_LifetimeRange{
+			LifetimeRange: &clickhouseConfig.ClickhouseConfig_ExternalDictionary_Range{
+				Min: int64(d.Get("lifetime_range.0.min").(int)),
+				Max: int64(d.Get("lifetime_range.0.max").(int)),
+			},
+		}
+	}
+
+	if _, ok := d.GetOk("http_source"); ok {
+		s := d.Get("http_source.0").(map[string]interface{})
+		dictionary.Source = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_HttpSource_{
+			HttpSource: &clickhouseConfig.ClickhouseConfig_ExternalDictionary_HttpSource{
+				Url:    s["url"].(string),
+				Format: s["format"].(string),
+			},
+		}
+	}
+
+	if _, ok := d.GetOk("mysql_source"); ok {
+		s := d.Get("mysql_source.0").(map[string]interface{})
+		source := &clickhouseConfig.ClickhouseConfig_ExternalDictionary_MysqlSource{
+			Db:              s["db"].(string),
+			Table:           s["table"].(string),
+			Port:            int64(s["port"].(int)),
+			User:            s["user"].(string),
+			Password:        s["password"].(string),
+			Where:           s["where"].(string),
+			InvalidateQuery: s["invalidate_query"].(string),
+		}
+		for _, v := range s["replica"].([]interface{}) {
+			r := v.(map[string]interface{})
+			source.Replicas = append(source.Replicas, &clickhouseConfig.ClickhouseConfig_ExternalDictionary_MysqlSource_Replica{
+				Host:     r["host"].(string),
+				Priority: int64(r["priority"].(int)),
+				Port:     int64(r["port"].(int)),
+				User:     r["user"].(string),
+				Password: r["password"].(string),
+			})
+		}
+		dictionary.Source = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_MysqlSource_{MysqlSource: source}
+	}
+
+	if _, ok := d.GetOk("clickhouse_source"); ok {
+		s := d.Get("clickhouse_source.0").(map[string]interface{})
+		dictionary.Source = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_ClickhouseSource_{
+			ClickhouseSource: &clickhouseConfig.ClickhouseConfig_ExternalDictionary_ClickhouseSource{
+				Db:       s["db"].(string),
+				Table:    s["table"].(string),
+				Host:     s["host"].(string),
+				Port:     int64(s["port"].(int)),
+				User:     s["user"].(string),
+				Password: s["password"].(string),
+				Where:    s["where"].(string),
+			},
+		}
+	}
+
+	if _, ok := d.GetOk("mongodb_source"); ok {
+		s := d.Get("mongodb_source.0").(map[string]interface{})
+		dictionary.Source = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_MongodbSource_{
+			MongodbSource: &clickhouseConfig.ClickhouseConfig_ExternalDictionary_MongodbSource{
+				Db:         s["db"].(string),
+				Collection: s["collection"].(string),
+				Host:       s["host"].(string),
+				Port:       int64(s["port"].(int)),
+				User:       s["user"].(string),
+				Password:   s["password"].(string),
+			},
+		}
+	}
+
+	if _, ok := d.GetOk("postgresql_source"); ok {
+		s := d.Get("postgresql_source.0").(map[string]interface{})
+		source := &clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource{
+			Db:              s["db"].(string),
+			Table:           s["table"].(string),
+			Hosts:           expandStringSlice(s["hosts"].([]interface{})),
+			Port:            int64(s["port"].(int)),
+			User:            s["user"].(string),
+			Password:        s["password"].(string),
+			InvalidateQuery: s["invalidate_query"].(string),
+		}
+		if v := s["ssl_mode"].(string); v != "" {
+			sslMode, err := expandEnum("ssl_mode", v, clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource_SslMode_value)
+			if err != nil {
+				return nil, err
+			}
+			source.SslMode = clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource_SslMode(*sslMode)
+		}
+		dictionary.Source = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource_{PostgresqlSource: source}
+	}
+
+	return dictionary, nil
+}
+
+func expandClickHouseDictionaryStructure(s map[string]interface{}) *clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure {
+	structure := &clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure{
+		Attributes: expandClickHouseDictionaryAttributes(s["attribute"].([]interface{})),
+	}
+
+	if v := s["id"].([]interface{}); len(v) > 0 {
+		structure.Id = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Id{
+			Name: v[0].(map[string]interface{})["name"].(string),
+		}
+	}
+	if v := s["key"].([]interface{}); len(v) > 0 {
+		structure.Key = &clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Key{
+			Attributes: expandClickHouseDictionaryAttributes(v[0].(map[string]interface{})["attribute"].([]interface{})),
+		}
+	}
+	if v := expandClickHouseDictionaryAttributes(s["range_min"].([]interface{})); len(v) > 0 {
+		structure.RangeMin = v[0]
+	}
+	if v := expandClickHouseDictionaryAttributes(s["range_max"].([]interface{})); len(v) > 0 {
+		structure.RangeMax = v[0]
+	}
+
+	return structure
+}
+
+func expandClickHouseDictionaryAttributes(attrs []interface{}) []*clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Attribute {
+	var result []*clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Attribute
+	for _, v := range attrs {
+		a := v.(map[string]interface{})
+		result = append(result, &clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Attribute{
+			Name:         a["name"].(string),
+			Type:         a["type"].(string),
+			NullValue:    a["null_value"].(string),
+			Expression:   a["expression"].(string),
+			Hierarchical: a["hierarchical"].(bool),
+			Injective:    a["injective"].(bool),
+		})
+	}
+	return result
+}
+
+// Sets attributes of the dictionary resource. Passwords are not returned by API, so they are kept from the state.
+func flattenClickHouseDictionary(d *schema.ResourceData, dict *clickhouseConfig.ClickhouseConfig_ExternalDictionary) error {
+	d.Set("name", dict.Name)
+
+	if err := d.Set("structure", flattenClickHouseDictionaryStructure(dict.Structure)); err != nil {
+		return err
+	}
+
+	err := d.Set("layout", []map[string]interface{}{
+		{
+			"type":          dict.GetLayout().GetType().String(),
+			"size_in_cells": dict.GetLayout().GetSizeInCells(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch lifetime := dict.Lifetime.(type) {
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_FixedLifetime:
+		d.Set("fixed_lifetime", lifetime.FixedLifetime)
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_LifetimeRange:
+		err := d.Set("lifetime_range", []map[string]interface{}{
+			{
+				"min": lifetime.LifetimeRange.GetMin(),
+				"max": lifetime.LifetimeRange.GetMax(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	switch source := dict.Source.(type) {
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_HttpSource_:
+		return d.Set("http_source", []map[string]interface{}{
+			{
+				"url":    source.HttpSource.Url,
+				"format": source.HttpSource.Format,
+			},
+		})
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_MysqlSource_:
+		s := source.MysqlSource
+		var replicas []map[string]interface{}
+		for i, r := range s.Replicas {
+			replicas = append(replicas, map[string]interface{}{
+				"host":     r.Host,
+				"priority": r.Priority,
+				"port":     r.Port,
+				"user":     r.User,
+				"password": d.Get(fmt.Sprintf("mysql_source.0.replica.%d.password", i)),
+			})
+		}
+		return d.Set("mysql_source", []map[string]interface{}{
+			{
+				"db":               s.Db,
+				"table":            s.Table,
+				"port":             s.Port,
+				"user":             s.User,
+				"password":         d.Get("mysql_source.0.password"),
+				"replica":          replicas,
+				"where":            s.Where,
+				"invalidate_query": s.InvalidateQuery,
+			},
+		})
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_ClickhouseSource_:
+		s := source.ClickhouseSource
+		return d.Set("clickhouse_source", []map[string]interface{}{
+			{
+				"db":       s.Db,
+				"table":    s.Table,
+				"host":     s.Host,
+				"port":     s.Port,
+				"user":     s.User,
+				"password": d.Get("clickhouse_source.0.password"),
+				"where":    s.Where,
+			},
+		})
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_MongodbSource_:
+		s := source.MongodbSource
+		return d.Set("mongodb_source", []map[string]interface{}{
+			{
+				"db":         s.Db,
+				"collection": s.Collection,
+				"host":       s.Host,
+				"port":       s.Port,
+				"user":       s.User,
+				"password":   d.Get("mongodb_source.0.password"),
+			},
+		})
+	case *clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource_:
+		s := source.PostgresqlSource
+		return d.Set("postgresql_source", []map[string]interface{}{
+			{
+				"db":               s.Db,
+				"table":            s.Table,
+				"hosts":            s.Hosts,
+				"port":             s.Port,
+				"user":             s.User,
+				"password":         d.Get("postgresql_source.0.password"),
+				"invalidate_query": s.InvalidateQuery,
+				"ssl_mode":         s.SslMode.String(),
+			},
+		})
+	}
+
+	return nil
+}
+
+func flattenClickHouseDictionaryStructure(s *clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure) []map[string]interface{} {
+	structure := map[string]interface{}{
+		"attribute": flattenClickHouseDictionaryAttributes(s.GetAttributes()),
+	}
+	if s.GetId() != nil {
+		structure["id"] = []map[string]interface{}{{"name": s.Id.Name}}
+	}
+	if s.GetKey() != nil {
+		structure["key"] = []map[string]interface{}{{"attribute": flattenClickHouseDictionaryAttributes(s.Key.Attributes)}}
+	}
+	if s.GetRangeMin() != nil {
+		structure["range_min"] = flattenClickHouseDictionaryAttributes(
+			[]*clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Attribute{s.RangeMin})
+	}
+	if s.GetRangeMax() != nil {
+		structure["range_max"] = flattenClickHouseDictionaryAttributes(
+			[]*clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Attribute{s.RangeMax})
+	}
+	return []map[string]interface{}{structure}
+}
+
+func flattenClickHouseDictionaryAttributes(attrs []*clickhouseConfig.ClickhouseConfig_ExternalDictionary_Structure_Attribute) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, a := range attrs {
+		result = append(result, map[string]interface{}{
+			"name":         a.Name,
+			"type":         a.Type,
+			"null_value":   a.NullValue,
+			"expression":   a.Expression,
+			"hierarchical": a.Hierarchical,
+			"injective":    a.Injective,
+		})
+	}
+	return result
+}
@@ -0,0 +1,172 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	clickhouseConfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1/config"
+)
+
+func TestClickHouseDeclaredShardNames(t *testing.T) {
+	hosts := []interface{}{
+		map[string]interface{}{"type": "CLICKHOUSE", "shard_name": ""},
+		map[string]interface{}{"type": "CLICKHOUSE", "shard_name": "shard2"},
+		map[string]interface{}{"type": "ZOOKEEPER", "shard_name": ""},
+	}
+
+	require.Equal(t, map[string]bool{"shard1": true, "shard2": true}, clickHouseDeclaredShardNames(hosts))
+	require.Empty(t, clickHouseDeclaredShardNames(nil))
+}
+
+func TestFilterClickHouseHostsByShards(t *testing.T) {
+	hosts := []*clickhouse.Host{
+		{Name: "ch1", Type: clickhouse.Host_CLICKHOUSE, ShardName: "shard1"},
+		{Name: "ch2", Type: clickhouse.Host_CLICKHOUSE, ShardName: "external"},
+		{Name: "zk1", Type: clickhouse.Host_ZOOKEEPER},
+	}
+
+	cases := []struct {
+		name     string
+		shards   map[string]bool
+		expected []string
+	}{
+		{
+			name:     "no declared shards",
+			shards:   map[string]bool{},
+			expected: []string{"ch1", "ch2", "zk1"},
+		},
+		{
+			name:     "external shard is ignored",
+			shards:   map[string]bool{"shard1": true},
+			expected: []string{"ch1", "zk1"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+			for _, h := range filterClickHouseHostsByShards(hosts, tc.shards) {
+				actual = append(actual, h.Name)
+			}
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestFilterClickHouseShardGroups(t *testing.T) {
+	groups := []*clickhouse.ShardGroup{
+		{Name: "inline"},
+		{Name: "external"},
+	}
+
+	require.Len(t, filterClickHouseShardGroups(groups, nil), 2)
+
+	actual := filterClickHouseShardGroups(groups, clickHouseDeclaredNames([]interface{}{
+		map[string]interface{}{"name": "inline"},
+	}))
+	require.Len(t, actual, 1)
+	require.Equal(t, "inline", actual[0].Name)
+}
+
+func TestFilterClickHouseShardHosts(t *testing.T) {
+	hosts := []*clickhouse.Host{
+		{Name: "a1", ZoneId: "ru-central1-a"},
+		{Name: "b1", ZoneId: "ru-central1-b"},
+		{Name: "b2", ZoneId: "ru-central1-b"},
+	}
+
+	cases := []struct {
+		name     string
+		declared []interface{}
+		expected []string
+	}{
+		{
+			name:     "import",
+			expected: []string{"a1", "b1", "b2"},
+		},
+		{
+			name: "known fqdn",
+			declared: []interface{}{
+				map[string]interface{}{"zone": "ru-central1-b", "fqdn": "b2"},
+			},
+			expected: []string{"b2"},
+		},
+		{
+			name: "new host claims remaining host of the zone",
+			declared: []interface{}{
+				map[string]interface{}{"zone": "ru-central1-b", "fqdn": "b1"},
+				map[string]interface{}{"zone": "ru-central1-b", "fqdn": ""},
+			},
+			expected: []string{"b1", "b2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+			for _, h := range filterClickHouseShardHosts(hosts, tc.declared) {
+				actual = append(actual, h.Name)
+			}
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestExpandFlattenClickHouseDictionary(t *testing.T) {
+	raw := map[string]interface{}{
+		"cluster_id": "cid",
+		"name":       "countries",
+		"structure": []interface{}{
+			map[string]interface{}{
+				"id": []interface{}{
+					map[string]interface{}{"name": "id"},
+				},
+				"attribute": []interface{}{
+					map[string]interface{}{"name": "name", "type": "String", "null_value": ""},
+				},
+			},
+		},
+		"layout": []interface{}{
+			map[string]interface{}{"type": "FLAT"},
+		},
+		"lifetime_range": []interface{}{
+			map[string]interface{}{"min": 300, "max": 600},
+		},
+		"postgresql_source": []interface{}{
+			map[string]interface{}{
+				"db":       "geo",
+				"table":    "countries",
+				"hosts":    []interface{}{"pg1.example.com", "pg2.example.com"},
+				"port":     6432,
+				"user":     "reader",
+				"password": "secret",
+				"ssl_mode": "VERIFY_FULL",
+			},
+		},
+	}
+	resourceSchema := resourceYandexMDBClickHouseDictionary().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, raw)
+
+	dict, err := expandClickHouseDictionary(d)
+	require.NoError(t, err)
+
+	require.Equal(t, "countries", dict.Name)
+	require.Equal(t, "id", dict.Structure.Id.Name)
+	require.Len(t, dict.Structure.Attributes, 1)
+	require.Equal(t, clickhouseConfig.ClickhouseConfig_ExternalDictionary_Layout_FLAT, dict.Layout.Type)
+	require.Equal(t, int64(600), dict.GetLifetimeRange().GetMax())
+	source := dict.GetPostgresqlSource()
+	require.NotNil(t, source)
+	require.Equal(t, []string{"pg1.example.com", "pg2.example.com"}, source.Hosts)
+	require.Equal(t, clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource_VERIFY_FULL, source.SslMode)
+
+	// password is not returned by API
+	source.Password = ""
+	require.NoError(t, flattenClickHouseDictionary(d, dict))
+	require.Equal(t, "secret", d.Get("postgresql_source.0.password"))
+	require.Equal(t, "FLAT", d.Get("layout.0.type"))
+	require.Equal(t, 300, d.Get("lifetime_range.0.min"))
+	require.Equal(t, "String", d.Get("structure.0.attribute.0.type"))
+}
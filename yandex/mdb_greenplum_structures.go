@@ -3,6 +3,8 @@ package yandex
 import (
 	"fmt"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
 )
 
@@ -14,3 +16,187 @@ func parseGreenplumEnv(e string) (greenplum.Cluster_Environment, error) {
 	}
 	return greenplum.Cluster_Environment(v), nil
 }
+
+func getGreenplumConfigFieldName(version string) string {
+	if version == "6.17" {
+		return "greenplum_config_6_17"
+	}
+	return "greenplum_config_6_19"
+}
+
+func flattenGreenplumMaintenanceWindow(mw *greenplum.MaintenanceWindow) ([]interface{}, error) {
+	maintenanceWindow := map[string]interface{}{}
+	if mw != nil {
+		switch p := mw.GetPolicy().(type) {
+		case *greenplum.MaintenanceWindow_Anytime:
+			maintenanceWindow["type"] = "ANYTIME"
+		case *greenplum.MaintenanceWindow_WeeklyMaintenanceWindow:
+			maintenanceWindow["type"] = "WEEKLY"
+			maintenanceWindow["hour"] = p.WeeklyMaintenanceWindow.Hour
+			maintenanceWindow["day"] = greenplum.WeeklyMaintenanceWindow_WeekDay_name[int32(p.WeeklyMaintenanceWindow.GetDay())]
+		default:
+			return nil, fmt.Errorf("unsupported Greenplum maintenance policy type")
+		}
+	}
+
+	return []interface{}{maintenanceWindow}, nil
+}
+
+func expandGreenplumMaintenanceWindow(d *schema.ResourceData) (*greenplum.MaintenanceWindow, error) {
+	if _, ok := d.GetOkExists("maintenance_window"); !ok {
+		return nil, nil
+	}
+
+	out := &greenplum.MaintenanceWindow{}
+	typeMW, _ := d.GetOk("maintenance_window.0.type")
+	if typeMW == "ANYTIME" {
+		if hour, ok := d.GetOk("maintenance_window.0.hour"); ok && hour != "" {
+			return nil, fmt.Errorf("hour should be not set, when using ANYTIME")
+		}
+		if day, ok := d.GetOk("maintenance_window.0.day"); ok && day != "" {
+			return nil, fmt.Errorf("day should be not set, when using ANYTIME")
+		}
+		out.Policy = &greenplum.MaintenanceWindow_Anytime{
+			Anytime: &greenplum.AnytimeMaintenanceWindow{},
+		}
+	} else if typeMW == "WEEKLY" {
+		hour := d.Get("maintenance_window.0.hour").(int)
+		dayString := d.Get("maintenance_window.0.day").(string)
+
+		day, ok := greenplum.WeeklyMaintenanceWindow_WeekDay_value[dayString]
+		if !ok || day == 0 {
+			return nil, fmt.Errorf(`day value should be one of ("MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN")`)
+		}
+
+		out.Policy = &greenplum.MaintenanceWindow_WeeklyMaintenanceWindow{
+			WeeklyMaintenanceWindow: &greenplum.WeeklyMaintenanceWindow{
+				Hour: int64(hour),
+				Day:  greenplum.WeeklyMaintenanceWindow_WeekDay(day),
+			},
+		}
+	} else {
+		return nil, fmt.Errorf("maintenance_window.0.type should be ANYTIME or WEEKLY")
+	}
+
+	return out, nil
+}
+
+func greenplumMaintenanceWindowSchemaValidateFunc(v interface{}, k string) (s []string, es []error) {
+	dayString := v.(string)
+	day, ok := greenplum.WeeklyMaintenanceWindow_WeekDay_value[dayString]
+	if !ok || day == 0 {
+		es = append(es, fmt.Errorf(`expected %s value should be one of ("MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"). Current value is %v`, k, v))
+		return
+	}
+
+	return
+}
+
+func flattenGreenplumPoolerConfig(c *greenplum.ConnectionPoolerConfig) []interface{} {
+	if c == nil {
+		return nil
+	}
+
+	out := map[string]interface{}{}
+	out["pooling_mode"] = c.GetMode().String()
+	out["pool_size"] = c.GetSize().GetValue()
+	out["pool_client_idle_timeout"] = c.GetClientIdleTimeout().GetValue()
+
+	return []interface{}{out}
+}
+
+func expandGreenplumPoolerConfig(d *schema.ResourceData) (*greenplum.ConnectionPoolerConfig, error) {
+	if _, ok := d.GetOk("pooler_config"); !ok {
+		return nil, nil
+	}
+
+	out := &greenplum.ConnectionPoolerConfig{}
+
+	if v, ok := d.GetOk("pooler_config.0.pooling_mode"); ok {
+		mode, err := parseGreenplumPoolingMode(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		out.Mode = mode
+	}
+
+	if v, ok := d.GetOk("pooler_config.0.pool_size"); ok {
+		out.Size = &wrappers.Int64Value{Value: int64(v.(int))}
+	}
+
+	if v, ok := d.GetOk("pooler_config.0.pool_client_idle_timeout"); ok {
+		out.ClientIdleTimeout = &wrappers.Int64Value{Value: int64(v.(int))}
+	}
+
+	return out, nil
+}
+
+func parseGreenplumPoolingMode(s string) (greenplum.ConnectionPoolerConfig_PoolMode, error) {
+	v, ok := greenplum.ConnectionPoolerConfig_PoolMode_value[s]
+	if !ok {
+		return 0, fmt.Errorf("value for 'pooling_mode' must be one of %s, not `%s`",
+			getJoinedKeys(getEnumValueMapKeys(greenplum.ConnectionPoolerConfig_PoolMode_value)), s)
+	}
+	return greenplum.ConnectionPoolerConfig_PoolMode(v), nil
+}
+
+func flattenGreenplumClusterConfig(c *greenplum.ClusterConfigSet) (map[string]string, error) {
+	var userConfig interface{}
+
+	if cf, ok := c.GetGreenplumConfig().(*greenplum.ClusterConfigSet_GreenplumConfigSet_6_17); ok {
+		userConfig = cf.GreenplumConfigSet_6_17.UserConfig
+	}
+	if cf, ok := c.GetGreenplumConfig().(*greenplum.ClusterConfigSet_GreenplumConfigSet_6_19); ok {
+		userConfig = cf.GreenplumConfigSet_6_19.UserConfig
+	}
+
+	if userConfig == nil {
+		return map[string]string{}, nil
+	}
+
+	return flattenResourceGenerateMapS(userConfig, false, mdbGreenplumSettingsFieldsInfo, false, true, nil)
+}
+
+func expandGreenplumConfigSpec(d *schema.ResourceData) (*greenplum.ConfigSpec, error) {
+	poolerConfig, err := expandGreenplumPoolerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	configSpec := &greenplum.ConfigSpec{
+		Pool: poolerConfig,
+	}
+
+	if _, ok := d.GetOkExists("greenplum_config"); !ok {
+		return configSpec, nil
+	}
+
+	version := d.Get("version").(string)
+	switch version {
+	case "6.17":
+		cfg := &greenplum.ConfigSpec_GreenplumConfig_6_17{
+			GreenplumConfig_6_17: &greenplum.GreenplumConfig6_17{},
+		}
+		if err := expandResourceGenerate(mdbGreenplumSettingsFieldsInfo, d, cfg.GreenplumConfig_6_17, "greenplum_config.", true); err != nil {
+			return nil, err
+		}
+		configSpec.GreenplumConfig = cfg
+	case "6.19":
+		cfg := &greenplum.ConfigSpec_GreenplumConfig_6_19{
+			GreenplumConfig_6_19: &greenplum.GreenplumConfig6_19{},
+		}
+		if err := expandResourceGenerate(mdbGreenplumSettingsFieldsInfo, d, cfg.GreenplumConfig_6_19, "greenplum_config.", true); err != nil {
+			return nil, err
+		}
+		configSpec.GreenplumConfig = cfg
+	default:
+		return nil, fmt.Errorf("unknown Greenplum version: %s but '6.17' and '6.19' are only available", version)
+	}
+
+	return configSpec, nil
+}
+
+var mdbGreenplumSettingsFieldsInfo = newObjectFieldsInfo().
+	addType(greenplum.GreenplumConfig6_17{}).
+	addType(greenplum.GreenplumConfig6_19{}).
+	addEnumGeneratedNames("log_statement", greenplum.LogStatement_name)
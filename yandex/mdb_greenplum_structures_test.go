@@ -0,0 +1,69 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+)
+
+func TestFlattenGreenplumClusterConfig(t *testing.T) {
+	t.Parallel()
+
+	m, err := flattenGreenplumClusterConfig(&greenplum.ClusterConfigSet{
+		GreenplumConfig: &greenplum.ClusterConfigSet_GreenplumConfigSet_6_19{
+			GreenplumConfigSet_6_19: &greenplum.GreenplumConfigSet6_19{
+				UserConfig: &greenplum.GreenplumConfig6_19{
+					MaxConnections:        &wrappers.Int64Value{Value: 395},
+					GpWorkfileCompression: &wrappers.BoolValue{Value: true},
+					LogStatement:          greenplum.LogStatement_MOD,
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"max_connections":         "395",
+		"gp_workfile_compression": "true",
+		"log_statement":           "MOD",
+	}, m)
+
+	m, err = flattenGreenplumClusterConfig(&greenplum.ClusterConfigSet{})
+	require.NoError(t, err)
+	require.Empty(t, m)
+}
+
+func TestExpandGreenplumConfigSpec(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]interface{}{
+		"version": "6.19",
+		"greenplum_config": map[string]interface{}{
+			"max_connections": "395",
+			"log_statement":   "MOD",
+		},
+		"pooler_config": []interface{}{
+			map[string]interface{}{
+				"pooling_mode":             "TRANSACTION",
+				"pool_size":                10,
+				"pool_client_idle_timeout": 0,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBGreenplumCluster().Schema, raw)
+
+	spec, err := expandGreenplumConfigSpec(d)
+	require.NoError(t, err)
+
+	cfg, ok := spec.GreenplumConfig.(*greenplum.ConfigSpec_GreenplumConfig_6_19)
+	require.True(t, ok)
+	require.Equal(t, int64(395), cfg.GreenplumConfig_6_19.GetMaxConnections().GetValue())
+	require.Equal(t, greenplum.LogStatement_MOD, cfg.GreenplumConfig_6_19.GetLogStatement())
+
+	require.Equal(t, greenplum.ConnectionPoolerConfig_TRANSACTION, spec.GetPool().GetMode())
+	require.Equal(t, int64(10), spec.GetPool().GetSize().GetValue())
+	require.Nil(t, spec.GetPool().GetClientIdleTimeout())
+}
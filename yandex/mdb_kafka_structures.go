@@ -460,7 +460,10 @@ func expandKafkaTopics(d *schema.ResourceData) ([]*kafka.TopicSpec, error) {
 }
 
 func expandKafkaUsers(d *schema.ResourceData) ([]*kafka.UserSpec, error) {
-	users := d.Get("user").(*schema.Set)
+	return expandKafkaUserSet(d.Get("user").(*schema.Set))
+}
+
+func expandKafkaUserSet(users *schema.Set) ([]*kafka.UserSpec, error) {
 	result := make([]*kafka.UserSpec, 0, users.Len())
 
 	for _, u := range users.List() {
@@ -832,14 +835,7 @@ func flattenKafkaUsers(users []*kafka.User, passwords map[string]string) *schema
 		u := map[string]interface{}{}
 		u["name"] = user.Name
 
-		perms := schema.NewSet(kafkaUserPermissionHash, nil)
-		for _, perm := range user.Permissions {
-			p := map[string]interface{}{}
-			p["topic_name"] = perm.TopicName
-			p["role"] = perm.Role.String()
-			perms.Add(p)
-		}
-		u["permission"] = perms
+		u["permission"] = flattenKafkaUserPermissions(user.Permissions)
 
 		if p, ok := passwords[user.Name]; ok {
 			u["password"] = p
@@ -849,6 +845,17 @@ func flattenKafkaUsers(users []*kafka.User, passwords map[string]string) *schema
 	return result
 }
 
+func flattenKafkaUserPermissions(permissions []*kafka.Permission) *schema.Set {
+	result := schema.NewSet(kafkaUserPermissionHash, nil)
+	for _, perm := range permissions {
+		p := map[string]interface{}{}
+		p["topic_name"] = perm.TopicName
+		p["role"] = perm.Role.String()
+		result.Add(p)
+	}
+	return result
+}
+
 func flattenKafkaHosts(hosts []*kafka.Host) *schema.Set {
 	result := schema.NewSet(kafkaHostHash, nil)
 
@@ -874,6 +881,16 @@ func kafkaUsersPasswords(users []*kafka.UserSpec) map[string]string {
 	return result
 }
 
+func filterKafkaUsers(users []*kafka.User, passwords map[string]string) []*kafka.User {
+	result := make([]*kafka.User, 0, len(passwords))
+	for _, user := range users {
+		if _, ok := passwords[user.Name]; ok {
+			result = append(result, user)
+		}
+	}
+	return result
+}
+
 func kafkaUsersDiff(currUsers []*kafka.User, targetUsers []*kafka.UserSpec) ([]string, []*kafka.UserSpec) {
 	m := map[string]bool{}
 	toAdd := []*kafka.UserSpec{}
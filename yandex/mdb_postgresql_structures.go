@@ -745,6 +745,60 @@ func flattenPGHostsInfo(d *schema.ResourceData, hs []*postgresql.Host) ([]*pgHos
 	return orderedHostsInfo, nil
 }
 
+// pgDeclaredHostFQDNs returns FQDNs of the hosts stored in the `host` block and whether
+// the block contains hosts which are not created yet.
+func pgDeclaredHostFQDNs(hosts []interface{}) (map[string]bool, bool) {
+	fqdns := make(map[string]bool)
+	pending := false
+	for _, h := range hosts {
+		fqdn := objx.New(h).Get("fqdn").Str()
+		if fqdn == "" {
+			pending = true
+			continue
+		}
+		fqdns[fqdn] = true
+	}
+	return fqdns, pending
+}
+
+// pgUnmanagedHosts returns FQDNs of the cluster hosts which are not declared in the `host` block.
+// When nothing is declared (e.g. on import) all hosts are considered managed by the cluster.
+func pgUnmanagedHosts(hosts []*postgresql.Host, declared map[string]bool) map[string]bool {
+	unmanaged := make(map[string]bool)
+	if len(declared) == 0 {
+		return unmanaged
+	}
+	for _, h := range hosts {
+		if !declared[h.Name] {
+			unmanaged[h.Name] = true
+		}
+	}
+	return unmanaged
+}
+
+func excludePGHosts(hosts []*postgresql.Host, excluded map[string]bool) []*postgresql.Host {
+	if len(excluded) == 0 {
+		return hosts
+	}
+	result := make([]*postgresql.Host, 0, len(hosts))
+	for _, h := range hosts {
+		if !excluded[h.Name] {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+func excludeUnmatchedPGHostsInfo(hostsInfo []*pgHostInfo, excluded map[string]bool) []*pgHostInfo {
+	result := make([]*pgHostInfo, 0, len(hostsInfo))
+	for _, hi := range hostsInfo {
+		if hi.inTargetSet || !excluded[hi.fqdn] {
+			result = append(result, hi)
+		}
+	}
+	return result
+}
+
 func getMasterHostname(orderedHostsInfo []*pgHostInfo) string {
 	for _, hostInfo := range orderedHostsInfo {
 		if hostInfo.name != "" && hostInfo.role == postgresql.Host_MASTER {
@@ -1,6 +1,10 @@
 package yandex
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
 
 func TestComparePGNoNamedHostInfo(t *testing.T) {
 	if !matchesPGNoNamedHostInfo(&pgHostInfo{
@@ -69,3 +73,45 @@ func TestComparePGNamedHostInfo(t *testing.T) {
 		t.Error("Compare host with equal zone and empty new subnetID should return 5")
 	}
 }
+
+func TestPGUnmanagedHosts(t *testing.T) {
+	hosts := []*postgresql.Host{{Name: "fq1"}, {Name: "fq2"}}
+
+	declared, pending := pgDeclaredHostFQDNs([]interface{}{
+		map[string]interface{}{"fqdn": "fq1"},
+	})
+	if pending {
+		t.Error("Hosts with FQDN should not be pending")
+	}
+
+	unmanaged := pgUnmanagedHosts(hosts, declared)
+	if len(unmanaged) != 1 || !unmanaged["fq2"] {
+		t.Errorf("Undeclared host should be unmanaged, got %v", unmanaged)
+	}
+
+	filtered := excludePGHosts(hosts, unmanaged)
+	if len(filtered) != 1 || filtered[0].Name != "fq1" {
+		t.Errorf("Unmanaged host should be excluded, got %v", filtered)
+	}
+
+	if len(pgUnmanagedHosts(hosts, map[string]bool{})) != 0 {
+		t.Error("All hosts should be managed when nothing is declared")
+	}
+
+	_, pending = pgDeclaredHostFQDNs([]interface{}{
+		map[string]interface{}{"fqdn": "fq1"},
+		map[string]interface{}{"fqdn": ""},
+	})
+	if !pending {
+		t.Error("Host without FQDN should be pending")
+	}
+
+	hostsInfo := excludeUnmatchedPGHostsInfo([]*pgHostInfo{
+		{fqdn: "fq1", inTargetSet: true},
+		{fqdn: "fq2", inTargetSet: true},
+		{fqdn: "fq3"},
+	}, map[string]bool{"fq2": true, "fq3": true})
+	if len(hostsInfo) != 2 || hostsInfo[1].fqdn != "fq2" {
+		t.Error("Only unmatched unmanaged hosts should be excluded")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
 	config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1/config"
+	"google.golang.org/genproto/googleapis/type/timeofday"
 )
 
 type redisConfig struct {
@@ -493,6 +494,51 @@ func expandRedisResources(d *schema.ResourceData) (*redis.Resources, error) {
 	return rs, nil
 }
 
+func flattenRedisBackupWindowStart(t *timeofday.TimeOfDay) []map[string]interface{} {
+	res := map[string]interface{}{}
+
+	res["hours"] = int(t.GetHours())
+	res["minutes"] = int(t.GetMinutes())
+
+	return []map[string]interface{}{res}
+}
+
+func expandRedisBackupWindowStart(d *schema.ResourceData) *timeofday.TimeOfDay {
+	out := &timeofday.TimeOfDay{}
+
+	if v, ok := d.GetOk("backup_window_start.0.hours"); ok {
+		out.Hours = int32(v.(int))
+	}
+
+	if v, ok := d.GetOk("backup_window_start.0.minutes"); ok {
+		out.Minutes = int32(v.(int))
+	}
+
+	return out
+}
+
+func flattenRedisAccess(a *redis.Access) []map[string]interface{} {
+	res := map[string]interface{}{}
+
+	res["data_lens"] = a.GetDataLens()
+
+	return []map[string]interface{}{res}
+}
+
+func expandRedisAccess(d *schema.ResourceData) *redis.Access {
+	if _, ok := d.GetOkExists("access"); !ok {
+		return nil
+	}
+
+	out := &redis.Access{}
+
+	if v, ok := d.GetOk("access.0.data_lens"); ok {
+		out.DataLens = v.(bool)
+	}
+
+	return out
+}
+
 func parseRedisWeekDay(wd string) (redis.WeeklyMaintenanceWindow_WeekDay, error) {
 	val, ok := redis.WeeklyMaintenanceWindow_WeekDay_value[wd]
 	// do not allow WEEK_DAY_UNSPECIFIED
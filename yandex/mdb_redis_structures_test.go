@@ -3,9 +3,11 @@ package yandex
 import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	"google.golang.org/genproto/googleapis/type/timeofday"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
 
@@ -729,3 +731,27 @@ func TestSortRedisHostsSharded(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandFlattenRedisBackupWindowAndAccess(t *testing.T) {
+	raw := map[string]interface{}{
+		"backup_window_start": []interface{}{
+			map[string]interface{}{"hours": 5, "minutes": 30},
+		},
+		"access": []interface{}{
+			map[string]interface{}{"data_lens": true},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBRedisCluster().Schema, raw)
+
+	bws := expandRedisBackupWindowStart(d)
+	require.Equal(t, int32(5), bws.Hours)
+	require.Equal(t, int32(30), bws.Minutes)
+	require.Equal(t, []map[string]interface{}{{"hours": 5, "minutes": 30}}, flattenRedisBackupWindowStart(bws))
+
+	access := expandRedisAccess(d)
+	require.True(t, access.DataLens)
+	require.Equal(t, []map[string]interface{}{{"data_lens": true}}, flattenRedisAccess(access))
+
+	require.Equal(t, []map[string]interface{}{{"hours": 0, "minutes": 0}}, flattenRedisBackupWindowStart(&timeofday.TimeOfDay{}))
+	require.Equal(t, []map[string]interface{}{{"data_lens": false}}, flattenRedisAccess(nil))
+}
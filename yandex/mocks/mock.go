@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/yandex-cloud/terraform-provider-yandex/yandex (interfaces: KafkaTopicModifier)
+// Source: github.com/yandex-cloud/terraform-provider-yandex/yandex (interfaces: KafkaTopicModifier,KafkaUserModifier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -77,3 +77,83 @@ func (mr *MockKafkaTopicModifierMockRecorder) UpdateKafkaTopic(arg0, arg1, arg2,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKafkaTopic", reflect.TypeOf((*MockKafkaTopicModifier)(nil).UpdateKafkaTopic), arg0, arg1, arg2, arg3)
 }
+
+// MockKafkaUserModifier is a mock of KafkaUserModifier interface.
+type MockKafkaUserModifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockKafkaUserModifierMockRecorder
+}
+
+// MockKafkaUserModifierMockRecorder is the mock recorder for MockKafkaUserModifier.
+type MockKafkaUserModifierMockRecorder struct {
+	mock *MockKafkaUserModifier
+}
+
+// NewMockKafkaUserModifier creates a new mock instance.
+func NewMockKafkaUserModifier(ctrl *gomock.Controller) *MockKafkaUserModifier {
+	mock := &MockKafkaUserModifier{ctrl: ctrl}
+	mock.recorder = &MockKafkaUserModifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKafkaUserModifier) EXPECT() *MockKafkaUserModifierMockRecorder {
+	return m.recorder
+}
+
+// CreateKafkaUser mocks base method.
+func (m *MockKafkaUserModifier) CreateKafkaUser(arg0 context.Context, arg1 *schema.ResourceData, arg2 *kafka.UserSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKafkaUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKafkaUser indicates an expected call of CreateKafkaUser.
+func (mr *MockKafkaUserModifierMockRecorder) CreateKafkaUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKafkaUser", reflect.TypeOf((*MockKafkaUserModifier)(nil).CreateKafkaUser), arg0, arg1, arg2)
+}
+
+// DeleteKafkaUser mocks base method.
+func (m *MockKafkaUserModifier) DeleteKafkaUser(arg0 context.Context, arg1 *schema.ResourceData, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKafkaUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKafkaUser indicates an expected call of DeleteKafkaUser.
+func (mr *MockKafkaUserModifierMockRecorder) DeleteKafkaUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKafkaUser", reflect.TypeOf((*MockKafkaUserModifier)(nil).DeleteKafkaUser), arg0, arg1, arg2)
+}
+
+// ListKafkaUsers mocks base method.
+func (m *MockKafkaUserModifier) ListKafkaUsers(arg0 context.Context, arg1 *schema.ResourceData) ([]*kafka.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKafkaUsers", arg0, arg1)
+	ret0, _ := ret[0].([]*kafka.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKafkaUsers indicates an expected call of ListKafkaUsers.
+func (mr *MockKafkaUserModifierMockRecorder) ListKafkaUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKafkaUsers", reflect.TypeOf((*MockKafkaUserModifier)(nil).ListKafkaUsers), arg0, arg1)
+}
+
+// UpdateKafkaUser mocks base method.
+func (m *MockKafkaUserModifier) UpdateKafkaUser(arg0 context.Context, arg1 *schema.ResourceData, arg2 *kafka.UpdateUserRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKafkaUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKafkaUser indicates an expected call of UpdateKafkaUser.
+func (mr *MockKafkaUserModifierMockRecorder) UpdateKafkaUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKafkaUser", reflect.TypeOf((*MockKafkaUserModifier)(nil).UpdateKafkaUser), arg0, arg1, arg2)
+}
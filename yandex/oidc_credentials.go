@@ -0,0 +1,130 @@
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+const (
+	defaultTokenExchangeEndpoint = "https://auth.yandex.cloud/oauth/token"
+
+	tokenExchangeGrantType          = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenExchangeRequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+	tokenExchangeSubjectTokenType   = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+// oidcTokenExchangeCredentials exchanges an external OIDC token for an IAM token of the service account
+// using OAuth 2.0 token exchange (RFC 8693). The SDK caches the IAM token until it expires.
+type oidcTokenExchangeCredentials struct {
+	serviceAccountID string
+	// subjectToken returns the OIDC token, it is called on every exchange so that the file can be renewed
+	subjectToken func() (string, error)
+	endpoint     string
+	client       *http.Client
+}
+
+func newOIDCTokenExchangeCredentials(serviceAccountID, token, tokenFile string) (*oidcTokenExchangeCredentials, error) {
+	if serviceAccountID == "" {
+		return nil, fmt.Errorf("'oidc_service_account_id' should be specified to authenticate with an OIDC token")
+	}
+
+	subjectToken := func() (string, error) {
+		return token, nil
+	}
+	if tokenFile != "" {
+		subjectToken = func() (string, error) {
+			content, err := ioutil.ReadFile(tokenFile)
+			if err != nil {
+				return "", fmt.Errorf("Error reading OIDC token file %q: %s", tokenFile, err)
+			}
+			return strings.TrimSpace(string(content)), nil
+		}
+	}
+
+	return &oidcTokenExchangeCredentials{
+		serviceAccountID: serviceAccountID,
+		subjectToken:     subjectToken,
+		endpoint:         defaultTokenExchangeEndpoint,
+		client:           &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+func (c *oidcTokenExchangeCredentials) YandexCloudAPICredentials() {}
+
+func (c *oidcTokenExchangeCredentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	subjectToken, err := c.subjectToken()
+	if err != nil {
+		return nil, err
+	}
+	if subjectToken == "" {
+		return nil, fmt.Errorf("OIDC token is empty")
+	}
+
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrantType},
+		"requested_token_type": {tokenExchangeRequestedTokenType},
+		"audience":             {c.serviceAccountID},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {tokenExchangeSubjectTokenType},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating token exchange request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error exchanging OIDC token for IAM token of service account %q: %s", c.serviceAccountID, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading token exchange response: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errorResponse struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &errorResponse) == nil && errorResponse.Error != "" {
+			return nil, fmt.Errorf("Error exchanging OIDC token for IAM token of service account %q: %s: %s",
+				c.serviceAccountID, errorResponse.Error, errorResponse.ErrorDescription)
+		}
+		return nil, fmt.Errorf("Error exchanging OIDC token for IAM token of service account %q: %s",
+			c.serviceAccountID, resp.Status)
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("Error parsing token exchange response: %s", err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, fmt.Errorf("Token exchange response does not contain an access token")
+	}
+
+	expiresAt := timestamppb.Now()
+	expiresAt.Seconds += tokenResponse.ExpiresIn - 1
+	expiresAt.Nanos = 0
+	return &iam.CreateIamTokenResponse{
+		IamToken:  tokenResponse.AccessToken,
+		ExpiresAt: expiresAt,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package yandex
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTokenExchangeServer(t *testing.T, subjectToken string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, tokenExchangeGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, tokenExchangeRequestedTokenType, r.PostForm.Get("requested_token_type"))
+		assert.Equal(t, tokenExchangeSubjectTokenType, r.PostForm.Get("subject_token_type"))
+		assert.Equal(t, "sa-id", r.PostForm.Get("audience"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("subject_token") != subjectToken {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_grant", "error_description": "token is not valid"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token": "t1.iam-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+}
+
+func TestOIDCTokenExchangeCredentials(t *testing.T) {
+	server := testTokenExchangeServer(t, "oidc-jwt")
+	defer server.Close()
+
+	creds, err := newOIDCTokenExchangeCredentials("sa-id", "oidc-jwt", "")
+	require.NoError(t, err)
+	creds.endpoint = server.URL
+
+	token, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.iam-token", token.IamToken)
+	assert.True(t, token.ExpiresAt.IsValid())
+
+	creds, err = newOIDCTokenExchangeCredentials("sa-id", "another-jwt", "")
+	require.NoError(t, err)
+	creds.endpoint = server.URL
+
+	_, err = creds.IAMToken(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_grant: token is not valid")
+}
+
+func TestOIDCTokenExchangeCredentialsFromFile(t *testing.T) {
+	server := testTokenExchangeServer(t, "renewed-jwt")
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "oidc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("expired-jwt\n"), 0600))
+
+	creds, err := newOIDCTokenExchangeCredentials("sa-id", "", tokenFile)
+	require.NoError(t, err)
+	creds.endpoint = server.URL
+
+	_, err = creds.IAMToken(context.Background())
+	assert.Error(t, err)
+
+	// the file is read again on the next exchange
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("renewed-jwt\n"), 0600))
+	token, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.iam-token", token.IamToken)
+}
+
+func TestOIDCTokenExchangeCredentialsRequireServiceAccount(t *testing.T) {
+	config := Config{OIDCToken: "oidc-jwt"}
+	_, err := config.credentials()
+	assert.Error(t, err)
+
+	config.OIDCServiceAccountID = "sa-id"
+	creds, err := config.credentials()
+	require.NoError(t, err)
+	assert.IsType(t, &oidcTokenExchangeCredentials{}, creds)
+}
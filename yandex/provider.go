@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -85,6 +86,33 @@ func provider(emptyFolder bool) *schema.Provider {
 				ConflictsWith: []string{"token"},
 				ValidateFunc:  validateSAKey,
 			},
+			"oidc_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("YC_OIDC_TOKEN", nil),
+				Description:   descriptions["oidc_token"],
+				ConflictsWith: []string{"token", "service_account_key_file", "oidc_token_file"},
+			},
+			"oidc_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("YC_OIDC_TOKEN_FILE", nil),
+				Description:   descriptions["oidc_token_file"],
+				ConflictsWith: []string{"token", "service_account_key_file"},
+			},
+			"oidc_service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("YC_OIDC_SERVICE_ACCOUNT_ID", nil),
+				Description: descriptions["oidc_service_account_id"],
+			},
+			"impersonate_service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("YC_IMPERSONATE_SERVICE_ACCOUNT_ID", nil),
+				Description: descriptions["impersonate_service_account_id"],
+			},
 			"storage_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -140,6 +168,19 @@ func provider(emptyFolder bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("YC_MESSAGE_QUEUE_SECRET_KEY", nil),
 				Description: descriptions["ymq_secret_key"],
 			},
+			"iam_wait_propagation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("YC_IAM_WAIT_PROPAGATION", false),
+				Description: descriptions["iam_wait_propagation"],
+			},
+			"iam_propagation_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("YC_IAM_PROPAGATION_TIMEOUT", defaultIAMPropagationTimeout.String()),
+				Description:  descriptions["iam_propagation_timeout"],
+				ValidateFunc: validateParsableValue(time.ParseDuration),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -159,9 +200,11 @@ func provider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_image":                                    dataSourceYandexComputeImage(),
 			"yandex_compute_instance":                                 dataSourceYandexComputeInstance(),
 			"yandex_compute_instance_group":                           dataSourceYandexComputeInstanceGroup(),
+			"yandex_compute_instances":                                dataSourceYandexComputeInstances(),
 			"yandex_compute_placement_group":                          dataSourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
+			"yandex_dns_recordset":                                    dataSourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
 			"yandex_function":                                         dataSourceYandexFunction(),
 			"yandex_function_scaling_policy":                          dataSourceYandexFunctionScalingPolicy(),
@@ -169,6 +212,7 @@ func provider(emptyFolder bool) *schema.Provider {
 			"yandex_iam_policy":                                       dataSourceYandexIAMPolicy(),
 			"yandex_iam_role":                                         dataSourceYandexIAMRole(),
 			"yandex_iam_service_account":                              dataSourceYandexIAMServiceAccount(),
+			"yandex_iam_service_accounts":                             dataSourceYandexIAMServiceAccounts(),
 			"yandex_iam_user":                                         dataSourceYandexIAMUser(),
 			"yandex_iot_core_device":                                  dataSourceYandexIoTCoreDevice(),
 			"yandex_iot_core_registry":                                dataSourceYandexIoTCoreRegistry(),
@@ -177,12 +221,17 @@ func provider(emptyFolder bool) *schema.Provider {
 			"yandex_lb_network_load_balancer":                         dataSourceYandexLBNetworkLoadBalancer(),
 			"yandex_lb_target_group":                                  dataSourceYandexLBTargetGroup(),
 			"yandex_logging_group":                                    dataSourceYandexLoggingGroup(),
+			"yandex_mdb_clickhouse_backups":                           dataSourceYandexMDBClickHouseBackups(),
 			"yandex_mdb_clickhouse_cluster":                           dataSourceYandexMDBClickHouseCluster(),
+			"yandex_mdb_elasticsearch_backups":                        dataSourceYandexMDBElasticsearchBackups(),
 			"yandex_mdb_elasticsearch_cluster":                        dataSourceYandexMDBElasticsearchCluster(),
+			"yandex_mdb_greenplum_backups":                            dataSourceYandexMDBGreenplumBackups(),
 			"yandex_mdb_greenplum_cluster":                            dataSourceYandexMDBGreenplumCluster(),
 			"yandex_mdb_kafka_cluster":                                dataSourceYandexMDBKafkaCluster(),
 			"yandex_mdb_kafka_topic":                                  dataSourceYandexMDBKafkaTopic(),
 			"yandex_mdb_kafka_connector":                              dataSourceYandexMDBKafkaConnector(),
+			"yandex_mdb_kafka_user":                                   dataSourceYandexMDBKafkaUser(),
+			"yandex_mdb_mongodb_backups":                              dataSourceYandexMDBMongodbBackups(),
 			"yandex_mdb_mongodb_cluster":                              dataSourceYandexMDBMongodbCluster(),
 			"yandex_mdb_mysql_cluster":                                dataSourceYandexMDBMySQLCluster(),
 			"yandex_mdb_mysql_database":                               dataSourceYandexMDBMySQLDatabase(),
@@ -190,13 +239,16 @@ func provider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_postgresql_cluster":                           dataSourceYandexMDBPostgreSQLCluster(),
 			"yandex_mdb_postgresql_database":                          dataSourceYandexMDBPostgreSQLDatabase(),
 			"yandex_mdb_postgresql_user":                              dataSourceYandexMDBPostgreSQLUser(),
+			"yandex_mdb_redis_backups":                                dataSourceYandexMDBRedisBackups(),
 			"yandex_mdb_redis_cluster":                                dataSourceYandexMDBRedisCluster(),
 			"yandex_mdb_sqlserver_cluster":                            dataSourceYandexMDBSQLServerCluster(),
 			"yandex_message_queue":                                    dataSourceYandexMessageQueue(),
 			"yandex_organizationmanager_saml_federation":              dataSourceYandexOrganizationManagerSamlFederation(),
 			"yandex_organizationmanager_saml_federation_user_account": dataSourceYandexOrganizationManagerSamlFederationUserAccount(),
 			"yandex_resourcemanager_cloud":                            dataSourceYandexResourceManagerCloud(),
+			"yandex_resourcemanager_clouds":                           dataSourceYandexResourceManagerClouds(),
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
+			"yandex_resourcemanager_folders":                          dataSourceYandexResourceManagerFolders(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
@@ -209,89 +261,125 @@ func provider(emptyFolder bool) *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"yandex_alb_backend_group":                            resourceYandexALBBackendGroup(),
-			"yandex_alb_http_router":                              resourceYandexALBHTTPRouter(),
-			"yandex_alb_load_balancer":                            resourceYandexALBLoadBalancer(),
-			"yandex_alb_target_group":                             resourceYandexALBTargetGroup(),
-			"yandex_alb_virtual_host":                             addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
-			"yandex_api_gateway":                                  resourceYandexApiGateway(),
-			"yandex_container_registry":                           resourceYandexContainerRegistry(),
-			"yandex_container_registry_iam_binding":               resourceYandexContainerRegistryIAMBinding(),
-			"yandex_container_repository":                         resourceYandexContainerRepository(),
-			"yandex_container_repository_iam_binding":             resourceYandexContainerRepositoryIAMBinding(),
-			"yandex_cdn_origin_group":                             resourceYandexCDNOriginGroup(),
-			"yandex_cdn_resource":                                 resourceYandexCDNResource(),
-			"yandex_compute_disk":                                 resourceYandexComputeDisk(),
-			"yandex_compute_disk_placement_group":                 resourceYandexComputeDiskPlacementGroup(),
-			"yandex_compute_image":                                resourceYandexComputeImage(),
-			"yandex_compute_instance":                             resourceYandexComputeInstance(),
-			"yandex_compute_instance_group":                       resourceYandexComputeInstanceGroup(),
-			"yandex_compute_placement_group":                      resourceYandexComputePlacementGroup(),
-			"yandex_compute_snapshot":                             resourceYandexComputeSnapshot(),
-			"yandex_dataproc_cluster":                             resourceYandexDataprocCluster(),
-			"yandex_datatransfer_endpoint":                        resourceYandexDatatransferEndpoint(),
-			"yandex_datatransfer_transfer":                        resourceYandexDatatransferTransfer(),
-			"yandex_dns_recordset":                                resourceYandexDnsRecordSet(),
-			"yandex_dns_zone":                                     resourceYandexDnsZone(),
-			"yandex_function":                                     resourceYandexFunction(),
-			"yandex_function_iam_binding":                         resourceYandexFunctionIAMBinding(),
-			"yandex_function_scaling_policy":                      resourceYandexFunctionScalingPolicy(),
-			"yandex_function_trigger":                             resourceYandexFunctionTrigger(),
-			"yandex_iam_service_account":                          resourceYandexIAMServiceAccount(),
-			"yandex_iam_service_account_api_key":                  resourceYandexIAMServiceAccountAPIKey(),
-			"yandex_iam_service_account_iam_binding":              resourceYandexIAMServiceAccountIAMBinding(),
-			"yandex_iam_service_account_iam_member":               resourceYandexIAMServiceAccountIAMMember(),
-			"yandex_iam_service_account_iam_policy":               resourceYandexIAMServiceAccountIAMPolicy(),
-			"yandex_iam_service_account_key":                      resourceYandexIAMServiceAccountKey(),
-			"yandex_iam_service_account_static_access_key":        resourceYandexIAMServiceAccountStaticAccessKey(),
-			"yandex_iot_core_device":                              resourceYandexIoTCoreDevice(),
-			"yandex_iot_core_registry":                            resourceYandexIoTCoreRegistry(),
-			"yandex_kms_secret_ciphertext":                        resourceYandexKMSSecretCiphertext(),
-			"yandex_kms_symmetric_key":                            resourceYandexKMSSymmetricKeyKey(),
-			"yandex_kms_symmetric_key_iam_binding":                resourceYandexKMSSymmetricKeyIAMBinding(),
-			"yandex_kubernetes_cluster":                           resourceYandexKubernetesCluster(),
-			"yandex_kubernetes_node_group":                        resourceYandexKubernetesNodeGroup(),
-			"yandex_lb_network_load_balancer":                     resourceYandexLBNetworkLoadBalancer(),
-			"yandex_lb_target_group":                              resourceYandexLBTargetGroup(),
-			"yandex_logging_group":                                resourceYandexLoggingGroup(),
-			"yandex_mdb_clickhouse_cluster":                       resourceYandexMDBClickHouseCluster(),
-			"yandex_mdb_elasticsearch_cluster":                    resourceYandexMDBElasticsearchCluster(),
-			"yandex_mdb_greenplum_cluster":                        resourceYandexMDBGreenplumCluster(),
-			"yandex_mdb_kafka_cluster":                            resourceYandexMDBKafkaCluster(),
-			"yandex_mdb_kafka_topic":                              resourceYandexMDBKafkaTopic(),
-			"yandex_mdb_kafka_connector":                          resourceYandexMDBKafkaConnector(),
-			"yandex_mdb_mongodb_cluster":                          resourceYandexMDBMongodbCluster(),
-			"yandex_mdb_mysql_cluster":                            resourceYandexMDBMySQLCluster(),
-			"yandex_mdb_mysql_database":                           resourceYandexMDBMySQLDatabase(),
-			"yandex_mdb_mysql_user":                               resourceYandexMDBMySQLUser(),
-			"yandex_mdb_postgresql_cluster":                       resourceYandexMDBPostgreSQLCluster(),
-			"yandex_mdb_postgresql_database":                      resourceYandexMDBPostgreSQLDatabase(),
-			"yandex_mdb_postgresql_user":                          resourceYandexMDBPostgreSQLUser(),
-			"yandex_mdb_redis_cluster":                            resourceYandexMDBRedisCluster(),
-			"yandex_mdb_sqlserver_cluster":                        resourceYandexMDBSQLServerCluster(),
-			"yandex_message_queue":                                resourceYandexMessageQueue(),
-			"yandex_organizationmanager_organization_iam_binding": resourceYandexOrganizationManagerOrganizationIAMBinding(),
-			"yandex_organizationmanager_organization_iam_member":  resourceYandexOrganizationManagerOrganizationIAMMember(),
-			"yandex_organizationmanager_saml_federation":          resourceYandexOrganizationManagerSamlFederation(),
-			"yandex_resourcemanager_cloud":                        resourceYandexResourceManagerCloud(),
-			"yandex_resourcemanager_cloud_iam_binding":            resourceYandexResourceManagerCloudIAMBinding(),
-			"yandex_resourcemanager_cloud_iam_member":             resourceYandexResourceManagerCloudIAMMember(),
-			"yandex_resourcemanager_folder":                       resourceYandexResourceManagerFolder(),
-			"yandex_resourcemanager_folder_iam_binding":           resourceYandexResourceManagerFolderIAMBinding(),
-			"yandex_resourcemanager_folder_iam_member":            resourceYandexResourceManagerFolderIAMMember(),
-			"yandex_resourcemanager_folder_iam_policy":            resourceYandexResourceManagerFolderIAMPolicy(),
-			"yandex_serverless_container":                         resourceYandexServerlessContainer(),
-			"yandex_storage_bucket":                               resourceYandexStorageBucket(),
-			"yandex_storage_object":                               resourceYandexStorageObject(),
-			"yandex_vpc_address":                                  resourceYandexVPCAddress(),
-			"yandex_vpc_default_security_group":                   resourceYandexVPCDefaultSecurityGroup(),
-			"yandex_vpc_network":                                  resourceYandexVPCNetwork(),
-			"yandex_vpc_route_table":                              resourceYandexVPCRouteTable(),
-			"yandex_vpc_security_group":                           resourceYandexVPCSecurityGroup(),
-			"yandex_vpc_security_group_rule":                      resourceYandexVpcSecurityGroupRule(),
-			"yandex_vpc_subnet":                                   resourceYandexVPCSubnet(),
-			"yandex_ydb_database_dedicated":                       resourceYandexYDBDatabaseDedicated(),
-			"yandex_ydb_database_serverless":                      resourceYandexYDBDatabaseServerless(),
+			"yandex_alb_backend_group":                                resourceYandexALBBackendGroup(),
+			"yandex_alb_http_router":                                  resourceYandexALBHTTPRouter(),
+			"yandex_alb_load_balancer":                                resourceYandexALBLoadBalancer(),
+			"yandex_alb_target_group":                                 resourceYandexALBTargetGroup(),
+			"yandex_alb_virtual_host":                                 addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
+			"yandex_api_gateway":                                      resourceYandexApiGateway(),
+			"yandex_api_gateway_iam_binding":                          resourceYandexApiGatewayIAMBinding(),
+			"yandex_api_gateway_iam_member":                           resourceYandexApiGatewayIAMMember(),
+			"yandex_api_gateway_iam_policy":                           resourceYandexApiGatewayIAMPolicy(),
+			"yandex_container_registry":                               resourceYandexContainerRegistry(),
+			"yandex_container_registry_iam_binding":                   resourceYandexContainerRegistryIAMBinding(),
+			"yandex_container_registry_iam_member":                    resourceYandexContainerRegistryIAMMember(),
+			"yandex_container_registry_iam_policy":                    resourceYandexContainerRegistryIAMPolicy(),
+			"yandex_container_repository":                             resourceYandexContainerRepository(),
+			"yandex_container_repository_iam_binding":                 resourceYandexContainerRepositoryIAMBinding(),
+			"yandex_container_repository_iam_member":                  resourceYandexContainerRepositoryIAMMember(),
+			"yandex_container_repository_iam_policy":                  resourceYandexContainerRepositoryIAMPolicy(),
+			"yandex_cdn_origin_group":                                 resourceYandexCDNOriginGroup(),
+			"yandex_cdn_resource":                                     resourceYandexCDNResource(),
+			"yandex_compute_disk":                                     resourceYandexComputeDisk(),
+			"yandex_compute_disk_placement_group":                     resourceYandexComputeDiskPlacementGroup(),
+			"yandex_compute_image":                                    resourceYandexComputeImage(),
+			"yandex_compute_instance":                                 resourceYandexComputeInstance(),
+			"yandex_compute_instance_group":                           resourceYandexComputeInstanceGroup(),
+			"yandex_compute_placement_group":                          resourceYandexComputePlacementGroup(),
+			"yandex_compute_snapshot":                                 resourceYandexComputeSnapshot(),
+			"yandex_dataproc_cluster":                                 resourceYandexDataprocCluster(),
+			"yandex_dataproc_job":                                     resourceYandexDataprocJob(),
+			"yandex_datatransfer_endpoint":                            resourceYandexDatatransferEndpoint(),
+			"yandex_datatransfer_transfer":                            resourceYandexDatatransferTransfer(),
+			"yandex_dns_recordset":                                    resourceYandexDnsRecordSet(),
+			"yandex_dns_zone":                                         resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                 resourceYandexDnsZoneRecords(),
+			"yandex_function":                                         resourceYandexFunction(),
+			"yandex_function_iam_binding":                             resourceYandexFunctionIAMBinding(),
+			"yandex_function_iam_member":                              resourceYandexFunctionIAMMember(),
+			"yandex_function_iam_policy":                              resourceYandexFunctionIAMPolicy(),
+			"yandex_function_scaling_policy":                          resourceYandexFunctionScalingPolicy(),
+			"yandex_function_trigger":                                 resourceYandexFunctionTrigger(),
+			"yandex_iam_service_account":                              resourceYandexIAMServiceAccount(),
+			"yandex_iam_service_account_api_key":                      resourceYandexIAMServiceAccountAPIKey(),
+			"yandex_iam_service_account_iam_binding":                  resourceYandexIAMServiceAccountIAMBinding(),
+			"yandex_iam_service_account_iam_member":                   resourceYandexIAMServiceAccountIAMMember(),
+			"yandex_iam_service_account_iam_policy":                   resourceYandexIAMServiceAccountIAMPolicy(),
+			"yandex_iam_service_account_key":                          resourceYandexIAMServiceAccountKey(),
+			"yandex_iam_service_account_static_access_key":            resourceYandexIAMServiceAccountStaticAccessKey(),
+			"yandex_iot_core_device":                                  resourceYandexIoTCoreDevice(),
+			"yandex_iot_core_registry":                                resourceYandexIoTCoreRegistry(),
+			"yandex_kms_secret_ciphertext":                            resourceYandexKMSSecretCiphertext(),
+			"yandex_kms_symmetric_key":                                resourceYandexKMSSymmetricKeyKey(),
+			"yandex_kms_symmetric_key_iam_binding":                    resourceYandexKMSSymmetricKeyIAMBinding(),
+			"yandex_kms_symmetric_key_iam_member":                     resourceYandexKMSSymmetricKeyIAMMember(),
+			"yandex_kms_symmetric_key_iam_policy":                     resourceYandexKMSSymmetricKeyIAMPolicy(),
+			"yandex_kubernetes_cluster":                               resourceYandexKubernetesCluster(),
+			"yandex_kubernetes_node_group":                            resourceYandexKubernetesNodeGroup(),
+			"yandex_lb_network_load_balancer":                         resourceYandexLBNetworkLoadBalancer(),
+			"yandex_lb_target_group":                                  resourceYandexLBTargetGroup(),
+			"yandex_logging_group":                                    resourceYandexLoggingGroup(),
+			"yandex_logging_group_iam_binding":                        resourceYandexLoggingGroupIAMBinding(),
+			"yandex_logging_group_iam_member":                         resourceYandexLoggingGroupIAMMember(),
+			"yandex_logging_group_iam_policy":                         resourceYandexLoggingGroupIAMPolicy(),
+			"yandex_mdb_clickhouse_cluster":                           resourceYandexMDBClickHouseCluster(),
+			"yandex_mdb_clickhouse_dictionary":                        resourceYandexMDBClickHouseDictionary(),
+			"yandex_mdb_clickhouse_format_schema":                     resourceYandexMDBClickHouseFormatSchema(),
+			"yandex_mdb_clickhouse_host":                              resourceYandexMDBClickHouseHost(),
+			"yandex_mdb_clickhouse_ml_model":                          resourceYandexMDBClickHouseMlModel(),
+			"yandex_mdb_clickhouse_shard":                             resourceYandexMDBClickHouseShard(),
+			"yandex_mdb_clickhouse_shard_group":                       resourceYandexMDBClickHouseShardGroup(),
+			"yandex_mdb_elasticsearch_cluster":                        resourceYandexMDBElasticsearchCluster(),
+			"yandex_mdb_elasticsearch_extension":                      resourceYandexMDBElasticsearchExtension(),
+			"yandex_mdb_greenplum_cluster":                            resourceYandexMDBGreenplumCluster(),
+			"yandex_mdb_kafka_cluster":                                resourceYandexMDBKafkaCluster(),
+			"yandex_mdb_kafka_topic":                                  resourceYandexMDBKafkaTopic(),
+			"yandex_mdb_kafka_connector":                              resourceYandexMDBKafkaConnector(),
+			"yandex_mdb_kafka_user":                                   resourceYandexMDBKafkaUser(),
+			"yandex_mdb_mongodb_cluster":                              resourceYandexMDBMongodbCluster(),
+			"yandex_mdb_mysql_cluster":                                resourceYandexMDBMySQLCluster(),
+			"yandex_mdb_mysql_database":                               resourceYandexMDBMySQLDatabase(),
+			"yandex_mdb_mysql_user":                                   resourceYandexMDBMySQLUser(),
+			"yandex_mdb_postgresql_cluster":                           resourceYandexMDBPostgreSQLCluster(),
+			"yandex_mdb_postgresql_cluster_switchover":                resourceYandexMDBPostgreSQLClusterSwitchover(),
+			"yandex_mdb_postgresql_database":                          resourceYandexMDBPostgreSQLDatabase(),
+			"yandex_mdb_postgresql_host":                              resourceYandexMDBPostgreSQLHost(),
+			"yandex_mdb_postgresql_user":                              resourceYandexMDBPostgreSQLUser(),
+			"yandex_mdb_redis_cluster":                                resourceYandexMDBRedisCluster(),
+			"yandex_mdb_sqlserver_cluster":                            resourceYandexMDBSQLServerCluster(),
+			"yandex_message_queue":                                    resourceYandexMessageQueue(),
+			"yandex_organizationmanager_organization_iam_binding":     resourceYandexOrganizationManagerOrganizationIAMBinding(),
+			"yandex_organizationmanager_organization_iam_member":      resourceYandexOrganizationManagerOrganizationIAMMember(),
+			"yandex_organizationmanager_organization_iam_policy":      resourceYandexOrganizationManagerOrganizationIAMPolicy(),
+			"yandex_organizationmanager_saml_federation":              resourceYandexOrganizationManagerSamlFederation(),
+			"yandex_organizationmanager_saml_federation_certificate":  resourceYandexOrganizationManagerSamlFederationCertificate(),
+			"yandex_organizationmanager_saml_federation_user_account": resourceYandexOrganizationManagerSamlFederationUserAccount(),
+			"yandex_resourcemanager_cloud":                            resourceYandexResourceManagerCloud(),
+			"yandex_resourcemanager_cloud_iam_binding":                resourceYandexResourceManagerCloudIAMBinding(),
+			"yandex_resourcemanager_cloud_iam_member":                 resourceYandexResourceManagerCloudIAMMember(),
+			"yandex_resourcemanager_cloud_iam_policy":                 resourceYandexResourceManagerCloudIAMPolicy(),
+			"yandex_resourcemanager_folder":                           resourceYandexResourceManagerFolder(),
+			"yandex_resourcemanager_folder_iam_binding":               resourceYandexResourceManagerFolderIAMBinding(),
+			"yandex_resourcemanager_folder_iam_member":                resourceYandexResourceManagerFolderIAMMember(),
+			"yandex_resourcemanager_folder_iam_policy":                resourceYandexResourceManagerFolderIAMPolicy(),
+			"yandex_serverless_container":                             resourceYandexServerlessContainer(),
+			"yandex_serverless_container_iam_binding":                 resourceYandexServerlessContainerIAMBinding(),
+			"yandex_serverless_container_iam_member":                  resourceYandexServerlessContainerIAMMember(),
+			"yandex_serverless_container_iam_policy":                  resourceYandexServerlessContainerIAMPolicy(),
+			"yandex_storage_bucket":                                   resourceYandexStorageBucket(),
+			"yandex_storage_object":                                   resourceYandexStorageObject(),
+			"yandex_vpc_address":                                      resourceYandexVPCAddress(),
+			"yandex_vpc_default_security_group":                       resourceYandexVPCDefaultSecurityGroup(),
+			"yandex_vpc_network":                                      resourceYandexVPCNetwork(),
+			"yandex_vpc_route_table":                                  resourceYandexVPCRouteTable(),
+			"yandex_vpc_security_group":                               resourceYandexVPCSecurityGroup(),
+			"yandex_vpc_security_group_rule":                          resourceYandexVpcSecurityGroupRule(),
+			"yandex_vpc_subnet":                                       resourceYandexVPCSubnet(),
+			"yandex_ydb_database_dedicated":                           resourceYandexYDBDatabaseDedicated(),
+			"yandex_ydb_database_iam_binding":                         resourceYandexYDBDatabaseIAMBinding(),
+			"yandex_ydb_database_iam_member":                          resourceYandexYDBDatabaseIAMMember(),
+			"yandex_ydb_database_iam_policy":                          resourceYandexYDBDatabaseIAMPolicy(),
+			"yandex_ydb_database_serverless":                          resourceYandexYDBDatabaseServerless(),
 		},
 	}
 
@@ -351,6 +439,17 @@ var descriptions = map[string]string{
 
 	"service_account_key_file": "Either the path to or the contents of a Service Account key file in JSON format.",
 
+	"oidc_token": "An OIDC token (JWT) issued by an external identity provider, e.g. a CI system. \n" +
+		"It is exchanged for an IAM token of the `oidc_service_account_id` service account.",
+
+	"oidc_token_file": "The path to a file with an OIDC token issued by an external identity provider. \n" +
+		"The file is read on every token exchange, so it can be renewed while Terraform is running.",
+
+	"oidc_service_account_id": "ID of the service account to get an IAM token for in exchange for the OIDC token.",
+
+	"impersonate_service_account_id": "ID of the service account to impersonate. All API calls are made with \n" +
+		"short-lived IAM tokens of this service account created on behalf of the provider credentials.",
+
 	"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 		"default value is `false`.",
 
@@ -374,12 +473,22 @@ var descriptions = map[string]string{
 
 	"ymq_secret_key": "Yandex.Cloud Message Queue service secret key. \n" +
 		"Used when a message queue resource doesn't have a secret key explicitly specified.",
+
+	"iam_wait_propagation": "Wait until changes made by IAM binding, member and policy resources are visible \n" +
+		"in access bindings of the resource. Default value is `false`.",
+
+	"iam_propagation_timeout": "The maximum time to wait for IAM changes to propagate, e.g. `1m30s`. \n" +
+		"Default value is `1m`.",
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Provider, emptyFolder bool) (interface{}, diag.Diagnostics) {
 	config := Config{
 		Token:                          d.Get("token").(string),
 		ServiceAccountKeyFileOrContent: d.Get("service_account_key_file").(string),
+		OIDCToken:                      d.Get("oidc_token").(string),
+		OIDCTokenFile:                  d.Get("oidc_token_file").(string),
+		OIDCServiceAccountID:           d.Get("oidc_service_account_id").(string),
+		ImpersonateServiceAccountID:    d.Get("impersonate_service_account_id").(string),
 		Region:                         d.Get("region_id").(string),
 		Zone:                           d.Get("zone").(string),
 		FolderID:                       d.Get("folder_id").(string),
@@ -395,9 +504,16 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		YMQEndpoint:                    d.Get("ymq_endpoint").(string),
 		YMQAccessKey:                   d.Get("ymq_access_key").(string),
 		YMQSecretKey:                   d.Get("ymq_secret_key").(string),
+		IAMWaitPropagation:             d.Get("iam_wait_propagation").(bool),
 		userAgent:                      p.UserAgent("terraform-provider-yandex", version.ProviderVersion),
 	}
 
+	iamPropagationTimeout, err := time.ParseDuration(d.Get("iam_propagation_timeout").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.IAMPropagationTimeout = iamPropagationTimeout
+
 	if emptyFolder {
 		config.FolderID = ""
 	}
@@ -0,0 +1,93 @@
+package yandex
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func newApiGatewayIamUpdaterByID(id string, config *Config) ResourceIamUpdater {
+	return &ApiGatewayIamUpdater{
+		apiGatewayID: id,
+		Config:       config,
+	}
+}
+
+func TestAccApiGatewayIamBinding_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-api-gateway")
+	fileBytes, _ := ioutil.ReadFile(specFile)
+	spec := string(fileBytes)
+
+	role := "viewer"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayIamBindingBasic(name, spec, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(apiGatewayResource, newApiGatewayIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_api_gateway_iam_binding.viewer",
+				ImportStateIdFunc: importIamResourceIDFunc(apiGatewayResource, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApiGatewayIamMember_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-api-gateway")
+	fileBytes, _ := ioutil.ReadFile(specFile)
+	spec := string(fileBytes)
+
+	role := "viewer"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayIamMemberBasic(name, spec, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(apiGatewayResource, newApiGatewayIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_api_gateway_iam_member.viewer",
+				ImportStateIdFunc: importIamResourceIDFunc(apiGatewayResource, role, member),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApiGatewayIamBindingBasic(name, spec, role, member string) string {
+	return testYandexAPIGatewayBasic(name, "iam test", "test-label", "label-value", spec) + fmt.Sprintf(`
+resource "yandex_api_gateway_iam_binding" "viewer" {
+  api_gateway_id = yandex_api_gateway.test-api-gateway.id
+  role           = "%s"
+  members        = ["%s"]
+}
+`, role, member)
+}
+
+func testAccApiGatewayIamMemberBasic(name, spec, role, member string) string {
+	return testYandexAPIGatewayBasic(name, "iam test", "test-label", "label-value", spec) + fmt.Sprintf(`
+resource "yandex_api_gateway_iam_member" "viewer" {
+  api_gateway_id = yandex_api_gateway.test-api-gateway.id
+  role           = "%s"
+  member         = "%s"
+}
+`, role, member)
+}
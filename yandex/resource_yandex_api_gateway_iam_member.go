@@ -0,0 +1,7 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexApiGatewayIAMMember() *schema.Resource {
+	return resourceIamMemberWithImport(IamApiGatewaySchema, newApiGatewayIamUpdater, apiGatewayIDParseFunc)
+}
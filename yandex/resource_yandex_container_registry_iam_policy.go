@@ -0,0 +1,7 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexContainerRegistryIAMPolicy() *schema.Resource {
+	return resourceIamPolicyWithImport(IamContainerRegistrySchema, newContainerRegistryIamUpdater, containerRegistryIDParseFunc)
+}
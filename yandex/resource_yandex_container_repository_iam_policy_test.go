@@ -0,0 +1,66 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+)
+
+func TestAccContainerRepositoryIamPolicy_basic(t *testing.T) {
+	var repository containerregistry.Repository
+	registryName := acctest.RandomWithPrefix("tf-container-registry")
+	repositoryNameSuffix := acctest.RandomWithPrefix("tf-container-repository")
+
+	role := "container-registry.images.puller"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRepositoryIamPolicyBasic(registryName, repositoryNameSuffix, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRepositoryExists(containerRepositoryResource, &repository),
+					testAccCheckContainerRepositoryIam(containerRepositoryResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_container_repository_iam_policy.foo",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return repository.Id, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the policy
+			{
+				Config: testAccContainerRepository(registryName, repositoryNameSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRepositoryEmptyIam(containerRepositoryResource),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerRepositoryIamPolicyBasic(registryName, repositoryNameSuffix, role, member string) string {
+	return testAccContainerRepository(registryName, repositoryNameSuffix) + fmt.Sprintf(`
+data "yandex_iam_policy" "foo" {
+  binding {
+    role    = "%s"
+    members = ["%s"]
+  }
+}
+
+resource "yandex_container_repository_iam_policy" "foo" {
+  repository_id = yandex_container_repository.test-repository.id
+  policy_data   = data.yandex_iam_policy.foo.policy_data
+}
+`, role, member)
+}
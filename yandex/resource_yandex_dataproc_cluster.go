@@ -210,6 +210,30 @@ func resourceYandexDataprocCluster() *schema.Resource {
 											Type: schema.TypeString,
 										},
 									},
+
+									"initialization_action": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"uri": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"args": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"timeout": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -286,6 +310,11 @@ func resourceYandexDataprocCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"log_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -405,6 +434,7 @@ func populateDataprocClusterResourceData(d *schema.ResourceData, config *Config,
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
+	d.Set("log_group_id", cluster.LogGroupId)
 
 	return nil
 }
@@ -485,6 +515,7 @@ func prepareDataprocCreateClusterRequest(d *schema.ResourceData, meta *Config) (
 		SecurityGroupIds:   expandSecurityGroupIds(d.Get("security_group_ids")),
 		HostGroupIds:       expandHostGroupIds(d.Get("host_group_ids")),
 		DeletionProtection: d.Get("deletion_protection").(bool),
+		LogGroupId:         d.Get("log_group_id").(string),
 	}
 
 	return &req, nil
@@ -605,10 +636,11 @@ func getDataprocClusterUpdateRequest(d *schema.ResourceData) (*dataproc.UpdateCl
 		UiProxy:            d.Get("ui_proxy").(bool),
 		SecurityGroupIds:   expandSecurityGroupIds(d.Get("security_group_ids")),
 		DeletionProtection: d.Get("deletion_protection").(bool),
+		LogGroupId:         d.Get("log_group_id").(string),
 	}
 
 	var updatePaths []string
-	fieldNames := []string{"description", "labels", "name", "service_account_id", "bucket", "ui_proxy", "security_group_ids", "deletion_protection"}
+	fieldNames := []string{"description", "labels", "name", "service_account_id", "bucket", "ui_proxy", "security_group_ids", "deletion_protection", "log_group_id"}
 	for _, fieldName := range fieldNames {
 		if d.HasChange(fieldName) {
 			updatePaths = append(updatePaths, fieldName)
@@ -115,6 +115,13 @@ func TestExpandDataprocClusterConfig(t *testing.T) {
 						"services":        []interface{}{"HDFS", "YARN"},
 						"properties":      map[string]interface{}{"prop1": "val1", "prop2": "val2"},
 						"ssh_public_keys": []interface{}{"id_rsa.pub", "id_dsa.pub"},
+						"initialization_action": []interface{}{
+							map[string]interface{}{
+								"uri":     "s3a://bucket-777/init.sh",
+								"args":    []interface{}{"arg1", "arg2"},
+								"timeout": 600,
+							},
+						},
 					},
 				},
 				"subcluster_spec": []interface{}{
@@ -192,6 +199,7 @@ func TestExpandDataprocClusterConfig(t *testing.T) {
 		"security_group_ids":  []interface{}{"security_group_id1"},
 		"host_group_ids":      []interface{}{"hg1", "hg2"},
 		"deletion_protection": "false",
+		"log_group_id":        "log-group-777",
 	}
 	resourceData := schema.TestResourceDataRaw(t, resourceYandexDataprocCluster().Schema, raw)
 
@@ -212,6 +220,13 @@ func TestExpandDataprocClusterConfig(t *testing.T) {
 				Services:      []dataproc.HadoopConfig_Service{dataproc.HadoopConfig_HDFS, dataproc.HadoopConfig_YARN},
 				Properties:    map[string]string{"prop1": "val1", "prop2": "val2"},
 				SshPublicKeys: []string{"id_rsa.pub", "id_dsa.pub"},
+				InitializationActions: []*dataproc.InitializationAction{
+					{
+						Uri:     "s3a://bucket-777/init.sh",
+						Args:    []string{"arg1", "arg2"},
+						Timeout: 600,
+					},
+				},
 			},
 			SubclustersSpec: []*dataproc.CreateSubclusterConfigSpec{
 				{
@@ -277,6 +292,7 @@ func TestExpandDataprocClusterConfig(t *testing.T) {
 		SecurityGroupIds:   []string{"security_group_id1"},
 		HostGroupIds:       []string{"hg2", "hg1"},
 		DeletionProtection: false,
+		LogGroupId:         "log-group-777",
 	}
 
 	assert.Equal(t, expected, req)
@@ -290,6 +306,13 @@ func TestFlattenDataprocClusterConfig(t *testing.T) {
 				Services:      []dataproc.HadoopConfig_Service{dataproc.HadoopConfig_HDFS, dataproc.HadoopConfig_YARN},
 				Properties:    map[string]string{"prop1": "val1", "prop2": "val2"},
 				SshPublicKeys: []string{"id_rsa.pub", "id_dsa.pub"},
+				InitializationActions: []*dataproc.InitializationAction{
+					{
+						Uri:     "s3a://bucket-777/init.sh",
+						Args:    []string{"arg1", "arg2"},
+						Timeout: 600,
+					},
+				},
 			},
 		},
 	}
@@ -369,6 +392,13 @@ func TestFlattenDataprocClusterConfig(t *testing.T) {
 					"services":        []string{"HDFS", "YARN"},
 					"properties":      map[string]string{"prop1": "val1", "prop2": "val2"},
 					"ssh_public_keys": []string{"id_rsa.pub", "id_dsa.pub"},
+					"initialization_action": []interface{}{
+						map[string]interface{}{
+							"uri":     "s3a://bucket-777/init.sh",
+							"args":    []string{"arg1", "arg2"},
+							"timeout": 600,
+						},
+					},
 				},
 			},
 			"subcluster_spec": []interface{}{
@@ -0,0 +1,408 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dataproc/v1"
+)
+
+const (
+	yandexDataprocJobCreateTimeout = 60 * time.Minute
+	yandexDataprocJobReadTimeout   = 1 * time.Minute
+	yandexDataprocJobDeleteTimeout = 15 * time.Minute
+)
+
+var dataprocJobSpecKeys = []string{"spark_job", "pyspark_job", "hive_job", "mapreduce_job"}
+
+func resourceYandexDataprocJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexDataprocJobCreate,
+		Read:   resourceYandexDataprocJobRead,
+		Delete: resourceYandexDataprocJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDataprocJobCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexDataprocJobReadTimeout),
+			Delete: schema.DefaultTimeout(yandexDataprocJobDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		// Jobs cannot be changed once submitted, so every argument forces a new job.
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"spark_job": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: dataprocJobSpecKeys,
+				Elem: &schema.Resource{
+					Schema: dataprocJobSchema(map[string]*schema.Schema{
+						"main_jar_file_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"main_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"packages":         dataprocJobStringListSchema(),
+						"repositories":     dataprocJobStringListSchema(),
+						"exclude_packages": dataprocJobStringListSchema(),
+					}),
+				},
+			},
+
+			"pyspark_job": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: dataprocJobSpecKeys,
+				Elem: &schema.Resource{
+					Schema: dataprocJobSchema(map[string]*schema.Schema{
+						"main_python_file_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"python_file_uris": dataprocJobStringListSchema(),
+						"packages":         dataprocJobStringListSchema(),
+						"repositories":     dataprocJobStringListSchema(),
+						"exclude_packages": dataprocJobStringListSchema(),
+					}),
+				},
+			},
+
+			"hive_job": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: dataprocJobSpecKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"properties": dataprocJobStringMapSchema(),
+						"continue_on_failure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"script_variables": dataprocJobStringMapSchema(),
+						"jar_file_uris":    dataprocJobStringListSchema(),
+						"query_file_uri": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"hive_job.0.query_list"},
+						},
+						"query_list": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"hive_job.0.query_file_uri"},
+						},
+					},
+				},
+			},
+
+			"mapreduce_job": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: dataprocJobSpecKeys,
+				Elem: &schema.Resource{
+					Schema: dataprocJobSchema(map[string]*schema.Schema{
+						"main_jar_file_uri": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"mapreduce_job.0.main_class"},
+						},
+						"main_class": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"mapreduce_job.0.main_jar_file_uri"},
+						},
+					}),
+				},
+			},
+
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"application_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"application_attempts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"am_container_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataprocJobSchema returns arguments shared by spark, pyspark and mapreduce jobs merged with job specific ones.
+func dataprocJobSchema(specific map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"args":          dataprocJobStringListSchema(),
+		"jar_file_uris": dataprocJobStringListSchema(),
+		"file_uris":     dataprocJobStringListSchema(),
+		"archive_uris":  dataprocJobStringListSchema(),
+		"properties":    dataprocJobStringMapSchema(),
+	}
+	for key, value := range specific {
+		result[key] = value
+	}
+	return result
+}
+
+func dataprocJobStringListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func dataprocJobStringMapSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		ForceNew: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func resourceYandexDataprocJobCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	req, err := prepareDataprocCreateJobRequest(d)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Job().Create(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create Data Proc Job in cluster %q: %s", req.ClusterId, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while getting Data Proc Job create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*dataproc.CreateJobMetadata)
+	if !ok {
+		return fmt.Errorf("could not get Data Proc Job ID from create operation metadata")
+	}
+
+	d.SetId(constructResourceId(req.ClusterId, md.JobId))
+
+	// Create operation is done only when the job reaches a terminal state.
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while waiting for Data Proc Job %q to finish: %s", md.JobId, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("Data Proc Job %q failed: %s", md.JobId, err)
+	}
+
+	log.Printf("[DEBUG] Finished Data Proc Job %q", md.JobId)
+	return resourceYandexDataprocJobRead(d, meta)
+}
+
+func prepareDataprocCreateJobRequest(d *schema.ResourceData) (*dataproc.CreateJobRequest, error) {
+	req := &dataproc.CreateJobRequest{
+		ClusterId: d.Get("cluster_id").(string),
+		Name:      d.Get("name").(string),
+	}
+
+	if v, ok := d.GetOk("spark_job.0"); ok {
+		req.SetSparkJob(expandDataprocSparkJob(v.(map[string]interface{})))
+	} else if v, ok := d.GetOk("pyspark_job.0"); ok {
+		req.SetPysparkJob(expandDataprocPysparkJob(v.(map[string]interface{})))
+	} else if v, ok := d.GetOk("hive_job.0"); ok {
+		req.SetHiveJob(expandDataprocHiveJob(v.(map[string]interface{})))
+	} else if v, ok := d.GetOk("mapreduce_job.0"); ok {
+		req.SetMapreduceJob(expandDataprocMapreduceJob(v.(map[string]interface{})))
+	} else {
+		return nil, fmt.Errorf("one of %v should be specified for Data Proc Job", dataprocJobSpecKeys)
+	}
+
+	return req, nil
+}
+
+func resourceYandexDataprocJobRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, jobID, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	job, err := config.sdk.Dataproc().Job().Get(ctx, &dataproc.GetJobRequest{
+		ClusterId: clusterID,
+		JobId:     jobID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Data Proc Job %q", jobID))
+	}
+
+	d.Set("cluster_id", job.ClusterId)
+	d.Set("job_id", job.Id)
+	d.Set("name", job.Name)
+	d.Set("status", job.Status.String())
+	d.Set("created_by", job.CreatedBy)
+	d.Set("created_at", getTimestamp(job.CreatedAt))
+	d.Set("started_at", getTimestamp(job.StartedAt))
+	d.Set("finished_at", getTimestamp(job.FinishedAt))
+
+	if err := d.Set("application_info", flattenDataprocApplicationInfo(job.ApplicationInfo)); err != nil {
+		return err
+	}
+
+	switch spec := job.JobSpec.(type) {
+	case *dataproc.Job_SparkJob:
+		err = d.Set("spark_job", flattenDataprocSparkJob(spec.SparkJob))
+	case *dataproc.Job_PysparkJob:
+		err = d.Set("pyspark_job", flattenDataprocPysparkJob(spec.PysparkJob))
+	case *dataproc.Job_HiveJob:
+		err = d.Set("hive_job", flattenDataprocHiveJob(spec.HiveJob))
+	case *dataproc.Job_MapreduceJob:
+		err = d.Set("mapreduce_job", flattenDataprocMapreduceJob(spec.MapreduceJob))
+	}
+	return err
+}
+
+func resourceYandexDataprocJobDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID, jobID, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	job, err := config.sdk.Dataproc().Job().Get(ctx, &dataproc.GetJobRequest{
+		ClusterId: clusterID,
+		JobId:     jobID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Data Proc Job %q", jobID))
+	}
+
+	// Finished jobs are kept in the job history of the cluster and cannot be deleted,
+	// so only jobs that are still running are cancelled.
+	if !isDataprocJobActive(job.Status) {
+		log.Printf("[DEBUG] Data Proc Job %q is already finished, removing it from state", jobID)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling Data Proc Job %q", jobID)
+	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Job().Cancel(ctx, &dataproc.CancelJobRequest{
+		ClusterId: clusterID,
+		JobId:     jobID,
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to cancel Data Proc Job %q: %s", jobID, err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while cancelling Data Proc Job %q: %s", jobID, err)
+	}
+
+	log.Printf("[DEBUG] Finished cancelling Data Proc Job %q", jobID)
+	return nil
+}
+
+func isDataprocJobActive(status dataproc.Job_Status) bool {
+	switch status {
+	case dataproc.Job_PROVISIONING, dataproc.Job_PENDING, dataproc.Job_RUNNING:
+		return true
+	}
+	return false
+}
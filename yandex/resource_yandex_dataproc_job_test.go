@@ -0,0 +1,200 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dataproc/v1"
+)
+
+func TestExpandDataprocJobRequest(t *testing.T) {
+	raw := map[string]interface{}{
+		"cluster_id": "cluster-777",
+		"name":       "spark-pi",
+		"spark_job": []interface{}{
+			map[string]interface{}{
+				"main_jar_file_uri": "file:///usr/lib/spark/examples/jars/spark-examples.jar",
+				"main_class":        "org.apache.spark.examples.SparkPi",
+				"args":              []interface{}{"1000"},
+				"properties":        map[string]interface{}{"spark.executor.memory": "1g"},
+				"packages":          []interface{}{"org.slf4j:slf4j-simple:1.7.30"},
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexDataprocJob().Schema, raw)
+
+	req, err := prepareDataprocCreateJobRequest(resourceData)
+	require.NoError(t, err)
+
+	expected := &dataproc.CreateJobRequest{
+		ClusterId: "cluster-777",
+		Name:      "spark-pi",
+		JobSpec: &dataproc.CreateJobRequest_SparkJob{
+			SparkJob: &dataproc.SparkJob{
+				Args:            []string{"1000"},
+				JarFileUris:     []string{},
+				FileUris:        []string{},
+				ArchiveUris:     []string{},
+				Properties:      map[string]string{"spark.executor.memory": "1g"},
+				MainJarFileUri:  "file:///usr/lib/spark/examples/jars/spark-examples.jar",
+				MainClass:       "org.apache.spark.examples.SparkPi",
+				Packages:        []string{"org.slf4j:slf4j-simple:1.7.30"},
+				Repositories:    []string{},
+				ExcludePackages: []string{},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, req)
+}
+
+func TestExpandFlattenDataprocHiveAndMapreduceJob(t *testing.T) {
+	hiveJob := expandDataprocHiveJob(map[string]interface{}{
+		"properties":          map[string]interface{}{},
+		"continue_on_failure": true,
+		"script_variables":    map[string]interface{}{"table": "t1"},
+		"jar_file_uris":       []interface{}{},
+		"query_file_uri":      "",
+		"query_list":          []interface{}{"SELECT 1;", "SELECT 2;"},
+	})
+	assert.Equal(t, []string{"SELECT 1;", "SELECT 2;"}, hiveJob.GetQueryList().GetQueries())
+	assert.Empty(t, hiveJob.GetQueryFileUri())
+
+	hive := flattenDataprocHiveJob(hiveJob)
+	assert.Equal(t, true, hive[0]["continue_on_failure"])
+	assert.Equal(t, []string{"SELECT 1;", "SELECT 2;"}, hive[0]["query_list"])
+	assert.Equal(t, "", hive[0]["query_file_uri"])
+
+	mapreduceJob := expandDataprocMapreduceJob(map[string]interface{}{
+		"args":              []interface{}{"-input", "s3a://bucket/in"},
+		"jar_file_uris":     []interface{}{},
+		"file_uris":         []interface{}{},
+		"archive_uris":      []interface{}{},
+		"properties":        map[string]interface{}{},
+		"main_jar_file_uri": "",
+		"main_class":        "org.apache.hadoop.streaming.HadoopStreaming",
+	})
+	assert.Equal(t, "org.apache.hadoop.streaming.HadoopStreaming", mapreduceJob.GetMainClass())
+
+	mapreduce := flattenDataprocMapreduceJob(mapreduceJob)
+	assert.Equal(t, "org.apache.hadoop.streaming.HadoopStreaming", mapreduce[0]["main_class"])
+	assert.Equal(t, "", mapreduce[0]["main_jar_file_uri"])
+}
+
+func TestFlattenDataprocApplicationInfo(t *testing.T) {
+	assert.Nil(t, flattenDataprocApplicationInfo(nil))
+
+	info := flattenDataprocApplicationInfo(&dataproc.ApplicationInfo{
+		Id: "application_1",
+		ApplicationAttempts: []*dataproc.ApplicationAttempt{
+			{Id: "appattempt_1", AmContainerId: "container_1"},
+		},
+	})
+
+	expected := []map[string]interface{}{
+		{
+			"id": "application_1",
+			"application_attempts": []map[string]interface{}{
+				{"id": "appattempt_1", "am_container_id": "container_1"},
+			},
+		},
+	}
+	assert.Equal(t, expected, info)
+}
+
+func TestAccDataprocJob_basic(t *testing.T) {
+	templateParams := defaultDataprocConfigParams(t)
+	sparkJobName := "yandex_dataproc_job.spark"
+	hiveJobName := "yandex_dataproc_job.hive"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataprocClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocJobConfig(t, templateParams),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sparkJobName, "name", "spark-pi"),
+					resource.TestCheckResourceAttr(sparkJobName, "status", "DONE"),
+					resource.TestCheckResourceAttr(sparkJobName, "spark_job.0.main_class", "org.apache.spark.examples.SparkPi"),
+					resource.TestCheckResourceAttrSet(sparkJobName, "job_id"),
+					resource.TestCheckResourceAttrSet(sparkJobName, "started_at"),
+					resource.TestCheckResourceAttrSet(sparkJobName, "finished_at"),
+					resource.TestCheckResourceAttrSet(sparkJobName, "application_info.0.id"),
+					testAccCheckDataprocJobStatus(sparkJobName, dataproc.Job_DONE),
+					resource.TestCheckResourceAttr(hiveJobName, "status", "DONE"),
+					resource.TestCheckResourceAttr(hiveJobName, "hive_job.0.query_list.#", "1"),
+					testAccCheckDataprocJobStatus(hiveJobName, dataproc.Job_DONE),
+				),
+			},
+			{
+				ResourceName:      sparkJobName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataprocJobStatus(n string, status dataproc.Job_Status) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterID, jobID, err := deconstructResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		job, err := config.sdk.Dataproc().Job().Get(context.Background(), &dataproc.GetJobRequest{
+			ClusterId: clusterID,
+			JobId:     jobID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if job.Status != status {
+			return fmt.Errorf("Expected Data Proc Job %q status to be %s, got %s", job.Id, status, job.Status)
+		}
+		return nil
+	}
+}
+
+func testAccDataprocJobConfig(t *testing.T, templateParams dataprocTFConfigParams) string {
+	return testAccDataprocClusterConfig(t, templateParams) + `
+resource "yandex_dataproc_job" "spark" {
+  cluster_id = yandex_dataproc_cluster.tf-dataproc-cluster.id
+  name       = "spark-pi"
+
+  spark_job {
+    main_jar_file_uri = "file:///usr/lib/spark/examples/jars/spark-examples.jar"
+    main_class        = "org.apache.spark.examples.SparkPi"
+    args              = ["100"]
+    properties = {
+      "spark.executor.memory" = "1g"
+    }
+  }
+}
+
+resource "yandex_dataproc_job" "hive" {
+  cluster_id = yandex_dataproc_cluster.tf-dataproc-cluster.id
+  name       = "hive-select"
+
+  hive_job {
+    query_list = ["SELECT 1;"]
+  }
+}
+`
+}
@@ -22,7 +22,7 @@ func resourceYandexDnsZone() *schema.Resource {
 		Update: resourceYandexDnsZoneUpdate,
 		Delete: resourceYandexDnsZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceDnsZoneImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -83,6 +83,12 @@ func resourceYandexDnsZone() *schema.Resource {
 				Set: schema.HashString,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -157,6 +163,10 @@ func resourceYandexDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceYandexDnsZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChanges("name", "description", "labels", "public", "private_networks") {
+		return resourceYandexDnsZoneRead(d, meta)
+	}
+
 	req, err := prepareDnsZoneUpdateRequest(d)
 	if err != nil {
 		return err
@@ -174,6 +184,10 @@ func resourceYandexDnsZoneDelete(d *schema.ResourceData, meta interface{}) error
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("DnsZone %q has deletion protection enabled, set `deletion_protection = false` and apply before destroying it", d.Id())
+	}
+
 	log.Printf("[DEBUG] Deleting DnsZone %q", d.Id())
 
 	req := &dns.DeleteDnsZoneRequest{
@@ -202,6 +216,14 @@ func resourceYandexDnsZoneDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceDnsZoneImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// deletion protection is enforced by provider only, so it can not be read from API
+	if err := d.Set("deletion_protection", false); err != nil {
+		return nil, fmt.Errorf("Error setting deletion_protection: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func prepareDnsZoneUpdateRequest(d *schema.ResourceData) (*dns.UpdateDnsZoneRequest, error) {
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
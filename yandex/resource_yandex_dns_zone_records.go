@@ -0,0 +1,405 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+const (
+	dnsRecordSetsPageSize  = 1000
+	dnsRecordSetsBatchSize = 100
+)
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexDnsZoneRecordsCreate,
+		Read:   resourceYandexDnsZoneRecordsRead,
+		Update: resourceYandexDnsZoneRecordsUpdate,
+		Delete: resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneRecordsImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 254),
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 20),
+						},
+
+						"ttl": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2147483647),
+						},
+
+						"data": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsRecordSetKey identifies record set within a zone: record sets are unique by fully qualified name and type.
+type dnsRecordSetKey struct {
+	name       string
+	recordType string
+}
+
+func resourceYandexDnsZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := getSDK(config).DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while getting DnsZone %q: %s", zoneID, err)
+	}
+
+	desired := expandDnsZoneRecords(d.Get("record").(*schema.Set), zone.Zone)
+	if err := syncDnsZoneRecords(ctx, config, zone, nil, desired, d.Get("exclusive").(bool)); err != nil {
+		return fmt.Errorf("DnsZoneRecords creation failed: %s", err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	zone, err := getSDK(config).DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZoneRecords %q", d.Id()))
+	}
+
+	existing, err := listDnsZoneRecordSets(ctx, config, zone.Id)
+	if err != nil {
+		return err
+	}
+
+	// Only record sets that are tracked in state are read back, so that records managed
+	// elsewhere do not show up as drift. In exclusive mode (and on import, when there is
+	// nothing in state yet) every record set of the zone is considered managed.
+	stateRecords := d.Get("record").(*schema.Set).List()
+	readAll := d.Get("exclusive").(bool) || len(stateRecords) == 0
+
+	var records []interface{}
+	seen := make(map[dnsRecordSetKey]bool)
+	for _, r := range stateRecords {
+		record := r.(map[string]interface{})
+		key := dnsRecordSetKey{
+			name:       dnsRecordSetFQDN(record["name"].(string), zone.Zone),
+			recordType: record["type"].(string),
+		}
+		rs, ok := existing[key]
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		records = append(records, flattenDnsZoneRecord(record["name"].(string), rs))
+	}
+
+	if readAll {
+		for _, key := range sortedDnsRecordSetKeys(existing) {
+			if seen[key] || isDnsZoneServiceRecordSet(existing[key], zone.Zone) {
+				continue
+			}
+			records = append(records, flattenDnsZoneRecord(existing[key].Name, existing[key]))
+		}
+	}
+
+	d.Set("zone_id", zone.Id)
+
+	return d.Set("record", records)
+}
+
+func resourceYandexDnsZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	zone, err := getSDK(config).DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("Error while getting DnsZone %q: %s", d.Id(), err)
+	}
+
+	o, n := d.GetChange("record")
+	current := expandDnsZoneRecords(o.(*schema.Set), zone.Zone)
+	desired := expandDnsZoneRecords(n.(*schema.Set), zone.Zone)
+
+	if err := syncDnsZoneRecords(ctx, config, zone, current, desired, d.Get("exclusive").(bool)); err != nil {
+		return fmt.Errorf("Error updating DnsZoneRecords %q: %s", d.Id(), err)
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting DnsZoneRecords %q", d.Id())
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	zone, err := getSDK(config).DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZoneRecords %q", d.Id()))
+	}
+
+	existing, err := listDnsZoneRecordSets(ctx, config, zone.Id)
+	if err != nil {
+		return err
+	}
+
+	var deletions []*dns.RecordSet
+	for key := range expandDnsZoneRecords(d.Get("record").(*schema.Set), zone.Zone) {
+		if rs, ok := existing[key]; ok && !isDnsZoneServiceRecordSet(rs, zone.Zone) {
+			deletions = append(deletions, rs)
+		}
+	}
+
+	if err := upsertDnsZoneRecordSets(ctx, config, zone.Id, deletions, nil); err != nil {
+		return fmt.Errorf("Error deleting DnsZoneRecords %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting DnsZoneRecords %q", d.Id())
+	return nil
+}
+
+func resourceDnsZoneRecordsImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("exclusive", false); err != nil {
+		return nil, fmt.Errorf("Error setting exclusive: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncDnsZoneRecords brings record sets of the zone from current to desired state with
+// a minimal set of replacements and deletions. When exclusive is set, every other record set
+// of the zone (except SOA and apex NS records) is deleted as well.
+func syncDnsZoneRecords(ctx context.Context, config *Config, zone *dns.DnsZone, current, desired map[dnsRecordSetKey]*dns.RecordSet, exclusive bool) error {
+	existing, err := listDnsZoneRecordSets(ctx, config, zone.Id)
+	if err != nil {
+		return err
+	}
+
+	var deletions, replacements []*dns.RecordSet
+
+	for _, key := range sortedDnsRecordSetKeys(existing) {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		rs := existing[key]
+		if isDnsZoneServiceRecordSet(rs, zone.Zone) {
+			continue
+		}
+		if _, managed := current[key]; managed || exclusive {
+			deletions = append(deletions, rs)
+		}
+	}
+
+	for _, key := range sortedDnsRecordSetKeys(desired) {
+		if rs, ok := existing[key]; ok && dnsRecordSetsEqual(rs, desired[key]) {
+			continue
+		}
+		replacements = append(replacements, desired[key])
+	}
+
+	return upsertDnsZoneRecordSets(ctx, config, zone.Id, deletions, replacements)
+}
+
+func upsertDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string, deletions, replacements []*dns.RecordSet) error {
+	for len(deletions) > 0 || len(replacements) > 0 {
+		req := &dns.UpsertRecordSetsRequest{
+			DnsZoneId: zoneID,
+		}
+
+		n := len(deletions)
+		if n > dnsRecordSetsBatchSize {
+			n = dnsRecordSetsBatchSize
+		}
+		req.Deletions, deletions = deletions[:n], deletions[n:]
+
+		m := len(replacements)
+		if m > dnsRecordSetsBatchSize-n {
+			m = dnsRecordSetsBatchSize - n
+		}
+		req.Replacements, replacements = replacements[:m], replacements[m:]
+
+		log.Printf("[DEBUG] Upserting record sets of DnsZone %q: %d deletions, %d replacements", zoneID, len(req.Deletions), len(req.Replacements))
+
+		op, err := getSDK(config).WrapOperation(getSDK(config).DNS().DnsZone().UpsertRecordSets(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to upsert record sets: %s", err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error while waiting operation to upsert record sets: %s", err)
+		}
+
+		if _, err := op.Response(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string) (map[dnsRecordSetKey]*dns.RecordSet, error) {
+	result := make(map[dnsRecordSetKey]*dns.RecordSet)
+	pageToken := ""
+	for {
+		resp, err := getSDK(config).DNS().DnsZone().ListRecordSets(ctx, &dns.ListDnsZoneRecordSetsRequest{
+			DnsZoneId: zoneID,
+			PageSize:  dnsRecordSetsPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error while getting list of record sets for DnsZone %q: %s", zoneID, err)
+		}
+		for _, rs := range resp.RecordSets {
+			result[dnsRecordSetKey{name: rs.Name, recordType: rs.Type}] = rs
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	return result, nil
+}
+
+func expandDnsZoneRecords(set *schema.Set, zone string) map[dnsRecordSetKey]*dns.RecordSet {
+	result := make(map[dnsRecordSetKey]*dns.RecordSet)
+	for _, r := range set.List() {
+		record := r.(map[string]interface{})
+		rs := &dns.RecordSet{
+			Name: dnsRecordSetFQDN(record["name"].(string), zone),
+			Type: record["type"].(string),
+			Ttl:  int64(record["ttl"].(int)),
+			Data: convertStringSet(record["data"].(*schema.Set)),
+		}
+		result[dnsRecordSetKey{name: rs.Name, recordType: rs.Type}] = rs
+	}
+	return result
+}
+
+func flattenDnsZoneRecord(name string, rs *dns.RecordSet) map[string]interface{} {
+	return map[string]interface{}{
+		"name": name,
+		"type": rs.Type,
+		"ttl":  int(rs.Ttl),
+		"data": schema.NewSet(schema.HashString, convertStringArrToInterface(rs.Data)),
+	}
+}
+
+// dnsRecordSetFQDN converts record name relative to zone (or '@' for zone apex) to fully qualified one.
+func dnsRecordSetFQDN(name, zone string) string {
+	switch {
+	case name == "@":
+		return zone
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + zone
+	}
+}
+
+// isDnsZoneServiceRecordSet reports whether record set is maintained by DNS service itself.
+func isDnsZoneServiceRecordSet(rs *dns.RecordSet, zone string) bool {
+	return rs.Type == "SOA" || (rs.Type == "NS" && rs.Name == zone)
+}
+
+func dnsRecordSetsEqual(a, b *dns.RecordSet) bool {
+	if a.Ttl != b.Ttl || len(a.Data) != len(b.Data) {
+		return false
+	}
+	aData := append([]string{}, a.Data...)
+	bData := append([]string{}, b.Data...)
+	sort.Strings(aData)
+	sort.Strings(bData)
+	for i := range aData {
+		if aData[i] != bData[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedDnsRecordSetKeys(m map[dnsRecordSetKey]*dns.RecordSet) []dnsRecordSetKey {
+	keys := make([]dnsRecordSetKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].recordType < keys[j].recordType
+	})
+	return keys
+}
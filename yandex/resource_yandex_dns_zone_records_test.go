@@ -0,0 +1,246 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func TestDnsRecordSetFQDN(t *testing.T) {
+	zone := "example.com."
+	cases := map[string]string{
+		"@":                "example.com.",
+		"srv":              "srv.example.com.",
+		"a.b":              "a.b.example.com.",
+		"srv.example.com.": "srv.example.com.",
+	}
+
+	for name, expected := range cases {
+		if actual := dnsRecordSetFQDN(name, zone); actual != expected {
+			t.Errorf("dnsRecordSetFQDN(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+}
+
+func TestDnsRecordSetsEqual(t *testing.T) {
+	cases := map[string]struct {
+		A, B  *dns.RecordSet
+		Equal bool
+	}{
+		"same data in different order": {
+			A:     &dns.RecordSet{Ttl: 200, Data: []string{"10.0.0.1", "10.0.0.2"}},
+			B:     &dns.RecordSet{Ttl: 200, Data: []string{"10.0.0.2", "10.0.0.1"}},
+			Equal: true,
+		},
+		"different ttl": {
+			A:     &dns.RecordSet{Ttl: 200, Data: []string{"10.0.0.1"}},
+			B:     &dns.RecordSet{Ttl: 300, Data: []string{"10.0.0.1"}},
+			Equal: false,
+		},
+		"different data": {
+			A:     &dns.RecordSet{Ttl: 200, Data: []string{"10.0.0.1"}},
+			B:     &dns.RecordSet{Ttl: 200, Data: []string{"10.0.0.1", "10.0.0.2"}},
+			Equal: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if actual := dnsRecordSetsEqual(tc.A, tc.B); actual != tc.Equal {
+			t.Errorf("%s: expected %t", tn, tc.Equal)
+		}
+	}
+}
+
+func TestIsDnsZoneServiceRecordSet(t *testing.T) {
+	zone := "example.com."
+	cases := map[string]struct {
+		RecordSet *dns.RecordSet
+		Expected  bool
+	}{
+		"soa":         {&dns.RecordSet{Name: zone, Type: "SOA"}, true},
+		"apex ns":     {&dns.RecordSet{Name: zone, Type: "NS"}, true},
+		"delegation":  {&dns.RecordSet{Name: "sub." + zone, Type: "NS"}, false},
+		"apex record": {&dns.RecordSet{Name: zone, Type: "A"}, false},
+	}
+
+	for tn, tc := range cases {
+		if actual := isDnsZoneServiceRecordSet(tc.RecordSet, zone); actual != tc.Expected {
+			t.Errorf("%s: expected %t", tn, tc.Expected)
+		}
+	}
+}
+
+func TestAccDNSZoneRecords_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+	resourceName := "yandex_dns_zone_records.records"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsBasic(zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv1."+fqdn, "A", true),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv2."+fqdn, "CNAME", true),
+				),
+			},
+			{
+				PreConfig: testAccDNSZoneRecordsCreateExternal(t, resourceName, "external."+fqdn),
+				Config:    testAccDNSZoneRecordsUpdate(zoneName, fqdn, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv1."+fqdn, "A", true),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv2."+fqdn, "CNAME", false),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv3."+fqdn, "TXT", true),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "external."+fqdn, "A", true),
+				),
+			},
+			{
+				Config: testAccDNSZoneRecordsUpdate(zoneName, fqdn, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv1."+fqdn, "A", true),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "srv3."+fqdn, "TXT", true),
+					testAccCheckDNSZoneRecordSetPresent(resourceName, "external."+fqdn, "A", false),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// imported records have fully qualified names and import is never exclusive
+				ImportStateVerifyIgnore: []string{"record", "exclusive"},
+			},
+		},
+	})
+}
+
+// testAccDNSZoneRecordsCreateExternal creates record set in the zone bypassing terraform.
+func testAccDNSZoneRecordsCreateExternal(t *testing.T, name, recordName string) func() {
+	return func() {
+		s := testAccProvider.Meta().(*Config)
+		sdk := getSDK(s)
+
+		zones, err := sdk.DNS().DnsZone().List(context.Background(), &dns.ListDnsZonesRequest{
+			FolderId: s.FolderID,
+		})
+		if err != nil {
+			t.Fatalf("failed to list dns zones: %s", err)
+		}
+
+		for _, zone := range zones.DnsZones {
+			if !strings.HasSuffix(recordName, "."+zone.Zone) {
+				continue
+			}
+			op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(context.Background(), &dns.UpdateRecordSetsRequest{
+				DnsZoneId: zone.Id,
+				Additions: []*dns.RecordSet{
+					{Name: recordName, Type: "A", Ttl: 200, Data: []string{"192.168.0.100"}},
+				},
+			}))
+			if err == nil {
+				err = op.Wait(context.Background())
+			}
+			if err != nil {
+				t.Fatalf("failed to create external record set for %s: %s", name, err)
+			}
+			return
+		}
+
+		t.Fatalf("dns zone for %s not found", recordName)
+	}
+}
+
+func testAccCheckDNSZoneRecordSetPresent(name, recordName, recordType string, isPresent bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		sdk := getSDK(testAccProvider.Meta().(*Config))
+		_, err := sdk.DNS().DnsZone().GetRecordSet(context.Background(), &dns.GetDnsZoneRecordSetRequest{
+			DnsZoneId: rs.Primary.Attributes["zone_id"],
+			Name:      recordName,
+			Type:      recordType,
+		})
+
+		if isPresent && err != nil {
+			return fmt.Errorf("record set %s %s not found: %s", recordType, recordName, err)
+		}
+		if !isPresent && err == nil {
+			return fmt.Errorf("record set %s %s still exists", recordType, recordName)
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSZoneRecordsBasic(name, fqdn string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%[1]s"
+  zone   = "%[2]s"
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  record {
+    name = "srv1"
+    type = "A"
+    ttl  = 200
+    data = ["192.168.0.1", "192.168.0.2"]
+  }
+
+  record {
+    name = "srv2.%[2]s"
+    type = "CNAME"
+    ttl  = 300
+    data = ["srv1.%[2]s"]
+  }
+}
+`, name, fqdn)
+}
+
+func testAccDNSZoneRecordsUpdate(name, fqdn string, exclusive bool) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%[1]s"
+  zone   = "%[2]s"
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  exclusive = %[3]t
+
+  record {
+    name = "srv1"
+    type = "A"
+    ttl  = 600
+    data = ["192.168.0.1"]
+  }
+
+  record {
+    name = "srv3"
+    type = "TXT"
+    ttl  = 300
+    data = ["\"v=spf1 -all\""]
+  }
+}
+`, name, fqdn, exclusive)
+}
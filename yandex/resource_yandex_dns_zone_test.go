@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -107,6 +108,41 @@ func TestAccDNSZone_update(t *testing.T) {
 	})
 }
 
+func TestAccDNSZone_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	var zone dns.DnsZone
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneDeletionProtection(zoneName, fqdn, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSZoneExists("yandex_dns_zone.zone1", &zone),
+					resource.TestCheckResourceAttr("yandex_dns_zone.zone1", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccDNSZoneDeletionProtection(zoneName, fqdn, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion protection enabled"),
+			},
+			{
+				Config: testAccDNSZoneDeletionProtection(zoneName, fqdn, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSZoneExists("yandex_dns_zone.zone1", &zone),
+					resource.TestCheckResourceAttr("yandex_dns_zone.zone1", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDNSZoneExists(name string, zone *dns.DnsZone) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -238,3 +274,14 @@ func testAccCheckDnsZoneDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccDNSZoneDeletionProtection(name, fqdn string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name                = "%s"
+  zone                = "%s"
+  public              = true
+  deletion_protection = %t
+}
+`, name, fqdn, deletionProtection)
+}
@@ -0,0 +1,7 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexFunctionIAMPolicy() *schema.Resource {
+	return resourceIamPolicyWithImport(IamFunctionSchema, newFunctionIamUpdater, functionIDParseFunc)
+}
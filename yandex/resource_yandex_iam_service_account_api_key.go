@@ -14,8 +14,11 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceYandexIAMServiceAccountAPIKeyCreate,
 		Read:   resourceYandexIAMServiceAccountAPIKeyRead,
+		Update: resourceYandexIAMServiceAccountAPIKeyUpdate,
 		Delete: resourceYandexIAMServiceAccountAPIKeyDelete,
 
+		CustomizeDiff: resourceYandexIAMKeyRotationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
@@ -37,6 +40,12 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"rotation_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateParsableValue(parseIamKeyRotationPeriod),
+			},
+
 			"secret_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -117,6 +126,11 @@ func resourceYandexIAMServiceAccountAPIKeyRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+// rotation_period is the only attribute which can be changed in place, and it is used by the provider only
+func resourceYandexIAMServiceAccountAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceYandexIAMServiceAccountAPIKeyRead(d, meta)
+}
+
 func resourceYandexIAMServiceAccountAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -38,6 +38,38 @@ func TestAccServiceAccountAPIKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountAPIKey_rotation(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "yandex_iam_service_account_api_key.acceptance"
+	accountName := "sa" + acctest.RandString(10)
+	var keyID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceAccountAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountAPIKeyConfigRotation(accountName, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "1h"),
+					testAccCheckResourceID(resourceName, &keyID),
+				),
+			},
+			{
+				// the key is older than the new rotation period, so it is replaced on every apply
+				Config: testAccServiceAccountAPIKeyConfigRotation(accountName, "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyExists(resourceName),
+					testAccCheckResourceIDChanged(resourceName, &keyID),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccServiceAccountAPIKey_encrypted(t *testing.T) {
 	t.Parallel()
 
@@ -120,6 +152,23 @@ resource "yandex_iam_service_account_api_key" "acceptance" {
 `, name, desc)
 }
 
+func testAccServiceAccountAPIKeyConfigRotation(name, rotationPeriod string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "acceptance" {
+  name = "%s"
+}
+
+resource "yandex_iam_service_account_api_key" "acceptance" {
+  service_account_id = "${yandex_iam_service_account.acceptance.id}"
+  rotation_period    = "%s"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, name, rotationPeriod)
+}
+
 func testAccServiceAccountAPIKeyConfigEncrypted(name, desc, key string) string {
 	return fmt.Sprintf(`
 resource "yandex_iam_service_account" "acceptance" {
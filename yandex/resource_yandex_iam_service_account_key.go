@@ -3,6 +3,8 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
@@ -17,6 +19,8 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 		Update: resourceYandexIAMServiceAccountKeyUpdate,
 		Delete: resourceYandexIAMServiceAccountKeyDelete,
 
+		CustomizeDiff: resourceYandexIAMKeyRotationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
@@ -51,6 +55,12 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"rotation_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateParsableValue(parseIamKeyRotationPeriod),
+			},
+
 			"public_key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -202,3 +212,48 @@ func resourceYandexIAMServiceAccountKeyDelete(d *schema.ResourceData, meta inter
 	d.SetId("")
 	return nil
 }
+
+func parseIamKeyRotationPeriod(s string) (time.Duration, error) {
+	period, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if period <= 0 {
+		return 0, fmt.Errorf("rotation period should be positive, got %q", s)
+	}
+	return period, nil
+}
+
+// resourceYandexIAMKeyRotationCustomizeDiff plans replacement of a key which is older than its rotation_period.
+// Keys have no expiration in API, so the age is computed from the creation time.
+func resourceYandexIAMKeyRotationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	v, ok := d.GetOk("rotation_period")
+	if !ok {
+		return nil
+	}
+
+	period, err := parseIamKeyRotationPeriod(v.(string))
+	if err != nil {
+		return err
+	}
+
+	createdAt, err := time.Parse(defaultTimeFormat, d.Get("created_at").(string))
+	if err != nil {
+		log.Printf("[WARN] Failed to parse creation time of key %q, skipping rotation: %s", d.Id(), err)
+		return nil
+	}
+
+	if time.Now().Before(createdAt.Add(period)) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Key %q created at %s is older than rotation period %s, it will be replaced", d.Id(), createdAt, period)
+	if err := d.SetNewComputed("created_at"); err != nil {
+		return err
+	}
+	return d.ForceNew("created_at")
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/helper/pgpkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 )
@@ -74,6 +78,85 @@ func TestAccServiceAccountKey_encrypted(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountKey_rotation(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "yandex_iam_service_account_key.acceptance"
+	accountName := "sa" + acctest.RandString(10)
+	var keyID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceAccountKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountKeyConfigRotation(accountName, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "1h"),
+					testAccCheckResourceID(resourceName, &keyID),
+				),
+			},
+			{
+				// the key is older than the new rotation period, so it is replaced on every apply
+				Config: testAccServiceAccountKeyConfigRotation(accountName, "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountKeyExists(resourceName),
+					testAccCheckResourceIDChanged(resourceName, &keyID),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestIAMKeyRotationCustomizeDiff(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"yandex_iam_service_account_key":     resourceYandexIAMServiceAccountKey(),
+		"yandex_iam_service_account_api_key": resourceYandexIAMServiceAccountAPIKey(),
+	}
+
+	cases := []struct {
+		name           string
+		age            time.Duration
+		rotationPeriod string
+		requiresNew    bool
+	}{
+		{name: "no rotation period", age: 365 * 24 * time.Hour},
+		{name: "fresh key", age: time.Hour, rotationPeriod: "720h"},
+		{name: "expired key", age: 721 * time.Hour, rotationPeriod: "720h", requiresNew: true},
+	}
+
+	for resourceType, r := range resources {
+		for _, c := range cases {
+			t.Run(resourceType+"/"+c.name, func(t *testing.T) {
+				state := &terraform.InstanceState{
+					ID: "key-id",
+					Attributes: map[string]string{
+						"id":                 "key-id",
+						"service_account_id": "sa-id",
+						"format":             "PEM_FILE",
+						"key_algorithm":      "RSA_2048",
+						"rotation_period":    c.rotationPeriod,
+						"created_at":         time.Now().Add(-c.age).Format(defaultTimeFormat),
+					},
+				}
+				raw := map[string]interface{}{
+					"service_account_id": "sa-id",
+				}
+				if c.rotationPeriod != "" {
+					raw["rotation_period"] = c.rotationPeriod
+				}
+
+				diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+				require.NoError(t, err)
+				assert.Equal(t, c.requiresNew, diff != nil && diff.RequiresNew())
+			})
+		}
+	}
+}
+
 func testAccCheckServiceAccountKeyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -128,6 +211,23 @@ resource "yandex_iam_service_account_key" "acceptance" {
 `, name, desc, keyDesc)
 }
 
+func testAccServiceAccountKeyConfigRotation(name, rotationPeriod string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "acceptance" {
+  name = "%s"
+}
+
+resource "yandex_iam_service_account_key" "acceptance" {
+  service_account_id = "${yandex_iam_service_account.acceptance.id}"
+  rotation_period    = "%s"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, name, rotationPeriod)
+}
+
 func testAccServiceAccountKeyConfigEncrypted(name, desc, key string) string {
 	return fmt.Sprintf(`
 resource "yandex_iam_service_account" "acceptance" {
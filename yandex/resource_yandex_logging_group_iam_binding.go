@@ -0,0 +1,7 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexLoggingGroupIAMBinding() *schema.Resource {
+	return resourceIamBindingWithImport(IamLoggingGroupSchema, newLoggingGroupIamUpdater, loggingGroupIDParseFunc)
+}
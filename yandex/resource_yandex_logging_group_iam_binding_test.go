@@ -0,0 +1,86 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func newLoggingGroupIamUpdaterByID(id string, config *Config) ResourceIamUpdater {
+	return &LoggingGroupIamUpdater{
+		logGroupID: id,
+		Config:     config,
+	}
+}
+
+func TestAccLoggingGroupIamBinding_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-logging-group")
+	role := "logging.reader"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingGroupIamBindingBasic(name, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(yandexLoggingGroupResource, newLoggingGroupIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_logging_group_iam_binding.reader",
+				ImportStateIdFunc: importIamResourceIDFunc(yandexLoggingGroupResource, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLoggingGroupIamMember_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-logging-group")
+	role := "logging.reader"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingGroupIamMemberBasic(name, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(yandexLoggingGroupResource, newLoggingGroupIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_logging_group_iam_member.reader",
+				ImportStateIdFunc: importIamResourceIDFunc(yandexLoggingGroupResource, role, member),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoggingGroupIamBindingBasic(name, role, member string) string {
+	return testYandexLoggingGroupBasic(name, "iam test", "test-label", "label-value") + fmt.Sprintf(`
+resource "yandex_logging_group_iam_binding" "reader" {
+  log_group_id = yandex_logging_group.test-logging-group.id
+  role         = "%s"
+  members      = ["%s"]
+}
+`, role, member)
+}
+
+func testAccLoggingGroupIamMemberBasic(name, role, member string) string {
+	return testYandexLoggingGroupBasic(name, "iam test", "test-label", "label-value") + fmt.Sprintf(`
+resource "yandex_logging_group_iam_member" "reader" {
+  log_group_id = yandex_logging_group.test-logging-group.id
+  role         = "%s"
+  member       = "%s"
+}
+`, role, member)
+}
@@ -0,0 +1,7 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexLoggingGroupIAMMember() *schema.Resource {
+	return resourceIamMemberWithImport(IamLoggingGroupSchema, newLoggingGroupIamUpdater, loggingGroupIDParseFunc)
+}
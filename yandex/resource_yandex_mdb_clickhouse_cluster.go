@@ -12,6 +12,7 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 )
 
 const (
@@ -707,6 +708,21 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restore": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -723,7 +739,14 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Create(ctx, req))
+	backupID, restore := d.GetOk("restore.0.backup_id")
+
+	var op *sdkoperation.Operation
+	if restore {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Restore(ctx, prepareClickHouseRestoreRequest(req, backupID.(string))))
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Create(ctx, req))
+	}
 	if err != nil {
 		return fmt.Errorf("error while requesting API to create ClickHouse Cluster: %s", err)
 	}
@@ -733,13 +756,15 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error while getting ClickHouse create operation metadata: %s", err)
 	}
 
-	md, ok := protoMetadata.(*clickhouse.CreateClusterMetadata)
-	if !ok {
+	switch md := protoMetadata.(type) {
+	case *clickhouse.CreateClusterMetadata:
+		d.SetId(md.ClusterId)
+	case *clickhouse.RestoreClusterMetadata:
+		d.SetId(md.ClusterId)
+	default:
 		return fmt.Errorf("could not get Cluster ID from create operation metadata")
 	}
 
-	d.SetId(md.ClusterId)
-
 	err = op.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error while waiting for operation to create ClickHouse Cluster: %s", err)
@@ -803,9 +828,33 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Restore request does not accept deletion protection, so it is applied after the cluster is restored.
+	if restore && req.DeletionProtection {
+		err = updateClickHouseDeletionProtection(ctx, config, d, true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexMDBClickHouseClusterRead(d, meta)
 }
 
+func prepareClickHouseRestoreRequest(req *clickhouse.CreateClusterRequest, backupID string) *clickhouse.RestoreClusterRequest {
+	return &clickhouse.RestoreClusterRequest{
+		BackupId:         backupID,
+		Name:             req.Name,
+		Description:      req.Description,
+		Labels:           req.Labels,
+		Environment:      req.Environment,
+		ConfigSpec:       req.ConfigSpec,
+		HostSpecs:        req.HostSpecs,
+		NetworkId:        req.NetworkId,
+		FolderId:         req.FolderId,
+		ServiceAccountId: req.ServiceAccountId,
+		SecurityGroupIds: req.SecurityGroupIds,
+	}
+}
+
 // Returns request for creating the Cluster and the map of the remaining shards to add.
 func prepareCreateClickHouseCreateRequest(d *schema.ResourceData, meta *Config) (*clickhouse.CreateClusterRequest, map[string][]*clickhouse.HostSpec, error) {
 	labels, err := expandLabels(d.Get("labels"))
@@ -977,6 +1026,8 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	declaredHosts := d.Get("host").([]interface{})
+	hosts = filterClickHouseHostsByShards(hosts, clickHouseDeclaredShardNames(declaredHosts))
 	hosts = sortClickHouseHosts(hosts, dHosts)
 	hs, err := flattenClickHouseHosts(hosts)
 	if err != nil {
@@ -992,6 +1043,11 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	// Keep all shard groups on import, otherwise ignore the ones managed outside of the cluster resource.
+	if len(declaredHosts) > 0 {
+		groups = filterClickHouseShardGroups(groups, clickHouseDeclaredNames(d.Get("shard_group").([]interface{})))
+	}
+
 	sg, err := flattenClickHouseShardGroups(groups)
 	if err != nil {
 		return err
@@ -1005,6 +1061,9 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+	if len(declaredHosts) > 0 {
+		formatSchemas = filterClickHouseFormatSchemas(formatSchemas, clickHouseDeclaredNames(d.Get("format_schema").(*schema.Set).List()))
+	}
 	fs, err := flattenClickHouseFormatSchemas(formatSchemas)
 	if err != nil {
 		return err
@@ -1017,6 +1076,9 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+	if len(declaredHosts) > 0 {
+		mlModels = filterClickHouseMlModels(mlModels, clickHouseDeclaredNames(d.Get("ml_model").(*schema.Set).List()))
+	}
 	ml, err := flattenClickHouseMlModels(mlModels)
 	if err != nil {
 		return err
@@ -1134,7 +1196,6 @@ var mdbClickHouseUpdateFieldsMap = map[string]string{
 	"version":                 "config_spec.version",
 	"access":                  "config_spec.access",
 	"backup_window_start":     "config_spec.backup_window_start",
-	"clickhouse":              "config_spec.clickhouse",
 	"admin_password":          "config_spec.admin_password",
 	"sql_user_management":     "config_spec.sql_user_management",
 	"sql_database_management": "config_spec.sql_database_management",
@@ -1153,7 +1214,7 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 	}
 
 	onDone := []func(){}
-	updatePath := []string{}
+	updatePath := clickHouseConfigUpdatePaths(d)
 	for field, path := range mdbClickHouseUpdateFieldsMap {
 		if d.HasChange(field) {
 			updatePath = append(updatePath, path)
@@ -1208,6 +1269,35 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// ClickHouse config fields which are named differently in API.
+var mdbClickHouseConfigUpdateFieldsMap = map[string]string{
+	"kafka_topic": "kafka_topics",
+}
+
+// clickHouseConfigUpdatePaths returns update mask paths for changed fields of the clickhouse block. The whole
+// `config_spec.clickhouse` is never sent, because it would overwrite external dictionaries managed by
+// `yandex_mdb_clickhouse_dictionary` resources, whose passwords are not returned by API.
+func clickHouseConfigUpdatePaths(d *schema.ResourceData) []string {
+	paths := []string{}
+	if d.HasChange("clickhouse.0.resources") {
+		paths = append(paths, "config_spec.clickhouse.resources")
+	}
+
+	configSchema := resourceYandexMDBClickHouseCluster().Schema["clickhouse"].Elem.(*schema.Resource).Schema["config"].Elem.(*schema.Resource).Schema
+	for field := range configSchema {
+		if !d.HasChange("clickhouse.0.config.0." + field) {
+			continue
+		}
+		path := field
+		if p, ok := mdbClickHouseConfigUpdateFieldsMap[field]; ok {
+			path = p
+		}
+		paths = append(paths, "config_spec.clickhouse.config."+path)
+	}
+
+	return paths
+}
+
 func getClickHouseClusterUpdateRequest(d *schema.ResourceData) (*clickhouse.UpdateClusterRequest, error) {
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
@@ -1333,6 +1423,9 @@ func updateClickHouseClusterHosts(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
+	oldHosts, newHosts := d.GetChange("host")
+	shardNames := clickHouseDeclaredShardNames(append(oldHosts.([]interface{}), newHosts.([]interface{})...))
+	currHosts = filterClickHouseHostsByShards(currHosts, shardNames)
 	targetHosts, err := expandClickHouseHosts(d)
 	if err != nil {
 		return err
@@ -1447,6 +1540,9 @@ func updateClickHouseClusterShardGroups(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
+	oldGroups, newGroups := d.GetChange("shard_group")
+	groupNames := clickHouseDeclaredNames(append(oldGroups.([]interface{}), newGroups.([]interface{})...))
+	currGroups = filterClickHouseShardGroups(currGroups, groupNames)
 	targetGroups, err := expandClickHouseShardGroups(d)
 	if err != nil {
 		return err
@@ -1485,6 +1581,9 @@ func updateClickHouseFormatSchemas(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
+	oldSchemas, newSchemas := d.GetChange("format_schema")
+	schemaNames := clickHouseDeclaredNames(append(oldSchemas.(*schema.Set).List(), newSchemas.(*schema.Set).List()...))
+	currSchemas = filterClickHouseFormatSchemas(currSchemas, schemaNames)
 	targetSchemas, err := expandClickHouseFormatSchemas(d)
 	if err != nil {
 		return err
@@ -1523,6 +1622,9 @@ func updateClickHouseMlModels(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+	oldModels, newModels := d.GetChange("ml_model")
+	modelNames := clickHouseDeclaredNames(append(oldModels.(*schema.Set).List(), newModels.(*schema.Set).List()...))
+	currModels = filterClickHouseMlModels(currModels, modelNames)
 	targetModels, err := expandClickHouseMlModels(d)
 	if err != nil {
 		return err
@@ -1922,6 +2024,24 @@ func updateClickHouseMaintenanceWindow(ctx context.Context, config *Config, d *s
 	return nil
 }
 
+func updateClickHouseDeletionProtection(ctx context.Context, config *Config, d *schema.ResourceData, deletionProtection bool) error {
+	op, err := config.sdk.WrapOperation(
+		config.sdk.MDB().Clickhouse().Cluster().Update(ctx, &clickhouse.UpdateClusterRequest{
+			ClusterId:          d.Id(),
+			DeletionProtection: deletionProtection,
+			UpdateMask:         &field_mask.FieldMask{Paths: []string{"deletion_protection"}},
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update deletion protection in ClickHouse Cluster %q: %s", d.Id(), err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating deletion protection in ClickHouse Cluster %q: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func listClickHouseHosts(ctx context.Context, config *Config, id string) ([]*clickhouse.Host, error) {
 	hosts := []*clickhouse.Host{}
 	pageToken := ""
@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 )
 
@@ -100,6 +102,57 @@ func mdbClickHouseClusterImportStep(name string) resource.TestStep {
 }
 
 // Test that a ClickHouse Cluster can be created, updated and destroyed
+// Test that cluster update does not send external dictionaries: passwords of their sources are not
+// returned by API, so dictionaries managed by yandex_mdb_clickhouse_dictionary would lose them.
+func TestClickHouseClusterUpdateKeepsDictionaries(t *testing.T) {
+	rawClickHouse := func(logLevel string, kafkaTopics []interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"resources": []interface{}{
+					map[string]interface{}{
+						"resource_preset_id": "s2.micro",
+						"disk_type_id":       "network-ssd",
+						"disk_size":          16,
+					},
+				},
+				"config": []interface{}{
+					map[string]interface{}{
+						"log_level":       logLevel,
+						"max_connections": 100,
+						"kafka_topic":     kafkaTopics,
+					},
+				},
+			},
+		}
+	}
+	rawInitial := map[string]interface{}{
+		"clickhouse": rawClickHouse("TRACE", nil),
+	}
+	rawTarget := map[string]interface{}{
+		"clickhouse": rawClickHouse("DEBUG", []interface{}{
+			map[string]interface{}{"name": "events"},
+		}),
+	}
+
+	clusterSchema := resourceYandexMDBClickHouseCluster().Schema
+	initial := schema.TestResourceDataRaw(t, clusterSchema, rawInitial)
+	initial.SetId("cluster1")
+	state := initial.State()
+	diff, err := schema.InternalMap(clusterSchema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawTarget), nil, nil, true)
+	require.NoError(t, err)
+	d, err := schema.InternalMap(clusterSchema).Data(state, diff)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{
+		"config_spec.clickhouse.config.log_level",
+		"config_spec.clickhouse.config.kafka_topics",
+	}, clickHouseConfigUpdatePaths(d))
+
+	req, err := getClickHouseClusterUpdateRequest(d)
+	require.NoError(t, err)
+	require.Empty(t, req.ConfigSpec.Clickhouse.Config.Dictionaries)
+}
+
 func TestAccMDBClickHouseCluster_full(t *testing.T) {
 	t.Parallel()
 
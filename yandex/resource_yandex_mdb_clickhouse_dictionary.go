@@ -0,0 +1,548 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	clickhouseConfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1/config"
+)
+
+const (
+	yandexMDBClickHouseDictionaryCreateTimeout = 30 * time.Minute
+	yandexMDBClickHouseDictionaryReadTimeout   = 1 * time.Minute
+	yandexMDBClickHouseDictionaryDeleteTimeout = 30 * time.Minute
+)
+
+var clickHouseDictionarySourceKeys = []string{
+	"http_source",
+	"mysql_source",
+	"clickhouse_source",
+	"mongodb_source",
+	"postgresql_source",
+}
+
+// External dictionaries can not be modified in place, so every attribute forces recreation.
+func resourceYandexMDBClickHouseDictionary() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBClickHouseDictionaryCreate,
+		Read:   resourceYandexMDBClickHouseDictionaryRead,
+		Delete: resourceYandexMDBClickHouseDictionaryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBClickHouseDictionaryCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBClickHouseDictionaryReadTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBClickHouseDictionaryDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"structure": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem:     resourceYandexMDBClickHouseDictionaryAttribute(),
+									},
+								},
+							},
+						},
+						"range_min": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     resourceYandexMDBClickHouseDictionaryAttribute(),
+						},
+						"range_max": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     resourceYandexMDBClickHouseDictionaryAttribute(),
+						},
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     resourceYandexMDBClickHouseDictionaryAttribute(),
+						},
+					},
+				},
+			},
+			"layout": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(getEnumValueMapKeysExt(clickhouseConfig.ClickhouseConfig_ExternalDictionary_Layout_Type_value, true), false),
+						},
+						"size_in_cells": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"fixed_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"fixed_lifetime", "lifetime_range"},
+			},
+			"lifetime_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"max": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"http_source": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: clickHouseDictionarySourceKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"mysql_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"replica": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"priority": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"password": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										ForceNew:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+						"where": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"invalidate_query": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"clickhouse_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"where": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"mongodb_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"collection": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"postgresql_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"hosts": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"invalidate_query": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ssl_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(getEnumValueMapKeysExt(clickhouseConfig.ClickhouseConfig_ExternalDictionary_PostgresqlSource_SslMode_value, true), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseDictionaryAttribute() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"null_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"hierarchical": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"injective": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseDictionaryCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	dictionary, err := expandClickHouseDictionary(d)
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().CreateExternalDictionary(ctx, &clickhouse.CreateClusterExternalDictionaryRequest{
+		ClusterId:          clusterID,
+		ExternalDictionary: dictionary,
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create external dictionary in ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	d.SetId(constructResourceId(clusterID, dictionary.Name))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while creating external dictionary in ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("external dictionary creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating ClickHouse external dictionary %q", dictionary.Name)
+
+	return resourceYandexMDBClickHouseDictionaryRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseDictionaryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, name, err := parseClickHouseClusterChildID(d.Id(), "dictionary")
+	if err != nil {
+		return err
+	}
+
+	cluster, err := config.sdk.MDB().Clickhouse().Cluster().Get(ctx, &clickhouse.GetClusterRequest{
+		ClusterId: clusterID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID))
+	}
+
+	var dictionary *clickhouseConfig.ClickhouseConfig_ExternalDictionary
+	for _, dict := range cluster.GetConfig().GetClickhouse().GetConfig().GetUserConfig().GetDictionaries() {
+		if dict.Name == name {
+			dictionary = dict
+			break
+		}
+	}
+	if dictionary == nil {
+		log.Printf("[WARN] Removing ClickHouse external dictionary %q because it's gone", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_id", clusterID)
+	return flattenClickHouseDictionary(d, dictionary)
+}
+
+func resourceYandexMDBClickHouseDictionaryDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Deleting ClickHouse external dictionary %q", name)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().DeleteExternalDictionary(ctx, &clickhouse.DeleteClusterExternalDictionaryRequest{
+		ClusterId:              clusterID,
+		ExternalDictionaryName: name,
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse external dictionary %q", name))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting external dictionary %q from ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting ClickHouse external dictionary %q", name)
+	return nil
+}
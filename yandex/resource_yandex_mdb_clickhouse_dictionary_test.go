@@ -0,0 +1,118 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+)
+
+const chDictionaryResource = "yandex_mdb_clickhouse_dictionary.countries"
+
+func TestAccMDBClickHouseDictionary_basic(t *testing.T) {
+	t.Parallel()
+
+	chName := acctest.RandomWithPrefix("tf-clickhouse-dictionary")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBClickHouseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseDictionaryConfig(chName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterHasDictionary(chResourceSharded, "countries"),
+					resource.TestCheckResourceAttr(chDictionaryResource, "layout.0.type", "HASHED"),
+					resource.TestCheckResourceAttr(chDictionaryResource, "fixed_lifetime", "300"),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chDictionaryResource),
+			{
+				Config: testAccMDBClickHouseDictionaryConfig(chName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterHasDictionary(chResourceSharded, "countries"),
+					resource.TestCheckResourceAttr(chDictionaryResource, "fixed_lifetime", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMDBClickHouseClusterHasDictionary(r, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		cluster, err := config.sdk.MDB().Clickhouse().Cluster().Get(context.Background(), &clickhouse.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dict := range cluster.GetConfig().GetClickhouse().GetConfig().GetUserConfig().GetDictionaries() {
+			if dict.Name == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("dictionary %q not found", name)
+	}
+}
+
+func testAccMDBClickHouseDictionaryConfig(name string, lifetime int) string {
+	return fmt.Sprintf(clickHouseVPCDependencies+`
+resource "yandex_mdb_clickhouse_cluster" "bar" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = "${yandex_vpc_network.mdb-ch-test-net.id}"
+
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  host {
+    type      = "CLICKHOUSE"
+    zone      = "ru-central1-a"
+    subnet_id = "${yandex_vpc_subnet.mdb-ch-test-subnet-a.id}"
+  }
+}
+
+resource "yandex_mdb_clickhouse_dictionary" "countries" {
+  cluster_id     = yandex_mdb_clickhouse_cluster.bar.id
+  name           = "countries"
+  fixed_lifetime = %d
+
+  structure {
+    id {
+      name = "id"
+    }
+    attribute {
+      name       = "name"
+      type       = "String"
+      null_value = ""
+    }
+  }
+
+  layout {
+    type = "HASHED"
+  }
+
+  http_source {
+    url    = "https://storage.yandexcloud.net/example/countries.tsv"
+    format = "TSV"
+  }
+}
+`, name, lifetime)
+}
@@ -0,0 +1,183 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBClickHouseFormatSchemaCreateTimeout = 10 * time.Minute
+	yandexMDBClickHouseFormatSchemaReadTimeout   = 1 * time.Minute
+	yandexMDBClickHouseFormatSchemaUpdateTimeout = 10 * time.Minute
+	yandexMDBClickHouseFormatSchemaDeleteTimeout = 10 * time.Minute
+)
+
+func resourceYandexMDBClickHouseFormatSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBClickHouseFormatSchemaCreate,
+		Read:   resourceYandexMDBClickHouseFormatSchemaRead,
+		Update: resourceYandexMDBClickHouseFormatSchemaUpdate,
+		Delete: resourceYandexMDBClickHouseFormatSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBClickHouseFormatSchemaCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBClickHouseFormatSchemaReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBClickHouseFormatSchemaUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBClickHouseFormatSchemaDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(getEnumValueMapKeys(clickhouse.FormatSchemaType_value), false),
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseFormatSchemaCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	formatSchema, err := expandClickHouseFormatSchema(map[string]interface{}{
+		"name": d.Get("name"),
+		"type": d.Get("type"),
+		"uri":  d.Get("uri"),
+	})
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().FormatSchema().Create(ctx, &clickhouse.CreateFormatSchemaRequest{
+		ClusterId:        clusterID,
+		FormatSchemaName: formatSchema.Name,
+		Type:             formatSchema.Type,
+		Uri:              formatSchema.Uri,
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create format schema in ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	d.SetId(constructResourceId(clusterID, formatSchema.Name))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while creating format schema in ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("format schema creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating ClickHouse format schema %q", formatSchema.Name)
+
+	return resourceYandexMDBClickHouseFormatSchemaRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseFormatSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, name, err := parseClickHouseClusterChildID(d.Id(), "format schema")
+	if err != nil {
+		return err
+	}
+
+	formatSchema, err := config.sdk.MDB().Clickhouse().FormatSchema().Get(ctx, &clickhouse.GetFormatSchemaRequest{
+		ClusterId:        clusterID,
+		FormatSchemaName: name,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Format schema %q", name))
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", formatSchema.Name)
+	d.Set("type", formatSchema.Type.String())
+	return d.Set("uri", formatSchema.Uri)
+}
+
+func resourceYandexMDBClickHouseFormatSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().FormatSchema().Update(ctx, &clickhouse.UpdateFormatSchemaRequest{
+		ClusterId:        clusterID,
+		FormatSchemaName: name,
+		Uri:              d.Get("uri").(string),
+		UpdateMask:       &field_mask.FieldMask{Paths: []string{"uri"}},
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update format schema %q in ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating format schema %q in ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished updating ClickHouse format schema %q", name)
+	return resourceYandexMDBClickHouseFormatSchemaRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseFormatSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Deleting ClickHouse format schema %q", name)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().FormatSchema().Delete(ctx, &clickhouse.DeleteFormatSchemaRequest{
+		ClusterId:        clusterID,
+		FormatSchemaName: name,
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse format schema %q", name))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting format schema %q from ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting ClickHouse format schema %q", name)
+	return nil
+}
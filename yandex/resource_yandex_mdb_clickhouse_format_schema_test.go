@@ -0,0 +1,94 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const chFormatSchemaResource = "yandex_mdb_clickhouse_format_schema.standalone"
+
+// Test that format schemas can be managed outside of the ClickHouse Cluster resource
+func TestAccMDBClickHouseFormatSchema_basic(t *testing.T) {
+	t.Parallel()
+
+	chName := acctest.RandomWithPrefix("tf-clickhouse-format-schema")
+	bucketName := acctest.RandomWithPrefix("tf-test-clickhouse-bucket")
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBClickHouseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseFormatSchemaConfig(chName, bucketName, rInt, "test.capnp"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chResourceSharded, "format_schema.#", "1"),
+					testAccCheckMDBClickHouseClusterHasFormatSchemas(chResourceSharded, map[string]map[string]string{
+						"inline": {
+							"type": "FORMAT_SCHEMA_TYPE_CAPNPROTO",
+							"uri":  "https://storage.yandexcloud.net/" + bucketName + "/test.capnp",
+						},
+						"standalone": {
+							"type": "FORMAT_SCHEMA_TYPE_CAPNPROTO",
+							"uri":  "https://storage.yandexcloud.net/" + bucketName + "/test.capnp",
+						},
+					}),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chFormatSchemaResource),
+			{
+				Config: testAccMDBClickHouseFormatSchemaConfig(chName, bucketName, rInt, "test2.capnp"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chResourceSharded, "format_schema.#", "1"),
+					resource.TestCheckResourceAttr(chFormatSchemaResource, "uri",
+						"https://storage.yandexcloud.net/"+bucketName+"/test2.capnp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBClickHouseFormatSchemaConfig(name, bucket string, randInt int, schemaFile string) string {
+	return fmt.Sprintf(clickHouseVPCDependencies+clickhouseObjectStorageDependencies(bucket, randInt)+`
+resource "yandex_mdb_clickhouse_cluster" "bar" {
+  depends_on = [
+    yandex_storage_object.test_ml_model
+  ]
+
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = "${yandex_vpc_network.mdb-ch-test-net.id}"
+
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  host {
+    type      = "CLICKHOUSE"
+    zone      = "ru-central1-a"
+    subnet_id = "${yandex_vpc_subnet.mdb-ch-test-subnet-a.id}"
+  }
+
+  format_schema {
+    name = "inline"
+    type = "FORMAT_SCHEMA_TYPE_CAPNPROTO"
+    uri  = "https://storage.yandexcloud.net/${yandex_storage_bucket.tmp_bucket.bucket}/test.capnp"
+  }
+}
+
+resource "yandex_mdb_clickhouse_format_schema" "standalone" {
+  cluster_id = yandex_mdb_clickhouse_cluster.bar.id
+  name       = "standalone"
+  type       = "FORMAT_SCHEMA_TYPE_CAPNPROTO"
+  uri        = "https://storage.yandexcloud.net/${yandex_storage_bucket.tmp_bucket.bucket}/%s"
+}
+`, name, schemaFile)
+}
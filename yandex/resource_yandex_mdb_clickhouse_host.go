@@ -0,0 +1,238 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBClickHouseHostCreateTimeout = 60 * time.Minute
+	yandexMDBClickHouseHostReadTimeout   = 1 * time.Minute
+	yandexMDBClickHouseHostUpdateTimeout = 30 * time.Minute
+	yandexMDBClickHouseHostDeleteTimeout = 30 * time.Minute
+)
+
+func resourceYandexMDBClickHouseHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBClickHouseHostCreate,
+		Read:   resourceYandexMDBClickHouseHostRead,
+		Update: resourceYandexMDBClickHouseHostUpdate,
+		Delete: resourceYandexMDBClickHouseHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBClickHouseHostCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBClickHouseHostReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBClickHouseHostUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBClickHouseHostDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      clickhouse.Host_CLICKHOUSE.String(),
+				ValidateFunc: validation.StringInSlice([]string{clickhouse.Host_CLICKHOUSE.String()}, false),
+			},
+			"shard_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"assign_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"copy_schema": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseHostCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	spec, err := expandClickHouseHost(map[string]interface{}{
+		"zone":             d.Get("zone"),
+		"type":             d.Get("type"),
+		"subnet_id":        d.Get("subnet_id"),
+		"shard_name":       d.Get("shard_name"),
+		"assign_public_ip": d.Get("assign_public_ip"),
+	})
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().AddHosts(ctx, &clickhouse.AddClusterHostsRequest{
+		ClusterId:  clusterID,
+		HostSpecs:  []*clickhouse.HostSpec{spec},
+		CopySchema: &wrappers.BoolValue{Value: d.Get("copy_schema").(bool)},
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to add host to ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while get ClickHouse host create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*clickhouse.AddClusterHostsMetadata)
+	if !ok || len(md.HostNames) != 1 {
+		return fmt.Errorf("could not get host name from create operation metadata")
+	}
+
+	d.SetId(constructResourceId(clusterID, md.HostNames[0]))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while adding host to ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("host creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating ClickHouse host %q", md.HostNames[0])
+
+	return resourceYandexMDBClickHouseHostRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseHostRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, fqdn, err := parseClickHouseClusterChildID(d.Id(), "host")
+	if err != nil {
+		return err
+	}
+
+	hosts, err := listClickHouseHosts(ctx, config, clusterID)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID))
+	}
+
+	var host *clickhouse.Host
+	for _, h := range hosts {
+		if h.Name == fqdn {
+			host = h
+			break
+		}
+	}
+	if host == nil {
+		log.Printf("[WARN] Removing ClickHouse host %q because it's gone", fqdn)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("zone", host.ZoneId)
+	d.Set("type", host.Type.String())
+	d.Set("shard_name", host.ShardName)
+	d.Set("subnet_id", host.SubnetId)
+	d.Set("assign_public_ip", host.AssignPublicIp)
+	return d.Set("fqdn", host.Name)
+}
+
+func resourceYandexMDBClickHouseHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	if d.HasChange("assign_public_ip") {
+		op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().UpdateHosts(ctx, &clickhouse.UpdateClusterHostsRequest{
+			ClusterId: clusterID,
+			UpdateHostSpecs: []*clickhouse.UpdateHostSpec{
+				{
+					HostName:       fqdn,
+					AssignPublicIp: &wrappers.BoolValue{Value: d.Get("assign_public_ip").(bool)},
+					UpdateMask:     &field_mask.FieldMask{Paths: []string{"assign_public_ip"}},
+				},
+			},
+		}))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to update host %q in ClickHouse Cluster %q: %s", fqdn, clusterID, err)
+		}
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error while updating host %q in ClickHouse Cluster %q: %s", fqdn, clusterID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating ClickHouse host %q", fqdn)
+	return resourceYandexMDBClickHouseHostRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseHostDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	log.Printf("[DEBUG] Deleting ClickHouse host %q", fqdn)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().DeleteHosts(ctx, &clickhouse.DeleteClusterHostsRequest{
+		ClusterId: clusterID,
+		HostNames: []string{fqdn},
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse host %q", fqdn))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting host %q from ClickHouse Cluster %q: %s", fqdn, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting ClickHouse host %q", fqdn)
+	return nil
+}
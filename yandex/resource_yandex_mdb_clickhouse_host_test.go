@@ -0,0 +1,77 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+)
+
+// Test that a host can be added to the shard managed outside of the ClickHouse Cluster resource
+func TestAccMDBClickHouseHost_basic(t *testing.T) {
+	t.Parallel()
+
+	var r clickhouse.Cluster
+	chName := acctest.RandomWithPrefix("tf-clickhouse-host")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBClickHouseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseHostClusterConfig(chName) + testAccMDBClickHouseHostConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterExists(chResourceSharded, &r, 3),
+					testAccCheckMDBClickHouseClusterHasShards(&r, []string{"shard1", "shard2"}),
+					resource.TestCheckResourceAttr(chResourceSharded, "host.#", "1"),
+					resource.TestCheckResourceAttr(chShardResource, "host.#", "1"),
+					resource.TestCheckResourceAttr(chHostResource, "type", "CLICKHOUSE"),
+					resource.TestCheckResourceAttr(chHostResource, "zone", "ru-central1-c"),
+					resource.TestCheckResourceAttr(chHostResource, "shard_name", "shard2"),
+					resource.TestCheckResourceAttrSet(chHostResource, "fqdn"),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chHostResource, "copy_schema"),
+		},
+	})
+}
+
+func testAccMDBClickHouseHostClusterConfig(name string) string {
+	return fmt.Sprintf(clickHouseVPCDependencies+`
+resource "yandex_mdb_clickhouse_cluster" "bar" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = "${yandex_vpc_network.mdb-ch-test-net.id}"
+
+  embedded_keeper = true
+
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  host {
+    type       = "CLICKHOUSE"
+    zone       = "ru-central1-a"
+    subnet_id  = "${yandex_vpc_subnet.mdb-ch-test-subnet-a.id}"
+    shard_name = "shard1"
+  }
+}
+
+resource "yandex_mdb_clickhouse_shard" "shard2" {
+  cluster_id = yandex_mdb_clickhouse_cluster.bar.id
+  name       = "shard2"
+
+  host {
+    zone      = "ru-central1-b"
+    subnet_id = "${yandex_vpc_subnet.mdb-ch-test-subnet-b.id}"
+  }
+}
+`, name)
+}
@@ -0,0 +1,183 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBClickHouseMlModelCreateTimeout = 10 * time.Minute
+	yandexMDBClickHouseMlModelReadTimeout   = 1 * time.Minute
+	yandexMDBClickHouseMlModelUpdateTimeout = 10 * time.Minute
+	yandexMDBClickHouseMlModelDeleteTimeout = 10 * time.Minute
+)
+
+func resourceYandexMDBClickHouseMlModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBClickHouseMlModelCreate,
+		Read:   resourceYandexMDBClickHouseMlModelRead,
+		Update: resourceYandexMDBClickHouseMlModelUpdate,
+		Delete: resourceYandexMDBClickHouseMlModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBClickHouseMlModelCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBClickHouseMlModelReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBClickHouseMlModelUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBClickHouseMlModelDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(getEnumValueMapKeys(clickhouse.MlModelType_value), false),
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseMlModelCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	mlModel, err := expandClickHouseMlModel(map[string]interface{}{
+		"name": d.Get("name"),
+		"type": d.Get("type"),
+		"uri":  d.Get("uri"),
+	})
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().MlModel().Create(ctx, &clickhouse.CreateMlModelRequest{
+		ClusterId:   clusterID,
+		MlModelName: mlModel.Name,
+		Type:        mlModel.Type,
+		Uri:         mlModel.Uri,
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create ml model in ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	d.SetId(constructResourceId(clusterID, mlModel.Name))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while creating ml model in ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("ml model creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating ClickHouse ml model %q", mlModel.Name)
+
+	return resourceYandexMDBClickHouseMlModelRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseMlModelRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, name, err := parseClickHouseClusterChildID(d.Id(), "ml model")
+	if err != nil {
+		return err
+	}
+
+	mlModel, err := config.sdk.MDB().Clickhouse().MlModel().Get(ctx, &clickhouse.GetMlModelRequest{
+		ClusterId:   clusterID,
+		MlModelName: name,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Format schema %q", name))
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", mlModel.Name)
+	d.Set("type", mlModel.Type.String())
+	return d.Set("uri", mlModel.Uri)
+}
+
+func resourceYandexMDBClickHouseMlModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().MlModel().Update(ctx, &clickhouse.UpdateMlModelRequest{
+		ClusterId:   clusterID,
+		MlModelName: name,
+		Uri:         d.Get("uri").(string),
+		UpdateMask:  &field_mask.FieldMask{Paths: []string{"uri"}},
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update ml model %q in ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating ml model %q in ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished updating ClickHouse ml model %q", name)
+	return resourceYandexMDBClickHouseMlModelRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseMlModelDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Deleting ClickHouse ml model %q", name)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().MlModel().Delete(ctx, &clickhouse.DeleteMlModelRequest{
+		ClusterId:   clusterID,
+		MlModelName: name,
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse ml model %q", name))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting ml model %q from ClickHouse Cluster %q: %s", name, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting ClickHouse ml model %q", name)
+	return nil
+}
@@ -0,0 +1,116 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const chMlModelResource = "yandex_mdb_clickhouse_ml_model.standalone"
+
+// Test that ml models can be managed outside of the ClickHouse Cluster resource
+func TestAccMDBClickHouseMlModel_basic(t *testing.T) {
+	t.Parallel()
+
+	chName := acctest.RandomWithPrefix("tf-clickhouse-ml-model")
+	bucketName := acctest.RandomWithPrefix("tf-test-clickhouse-bucket")
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBClickHouseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseMlModelConfig(chName, bucketName, rInt, "train.csv"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chResourceSharded, "ml_model.#", "1"),
+					testAccCheckMDBClickHouseClusterHasMlModels(chResourceSharded, map[string]map[string]string{
+						"inline": {
+							"type": "ML_MODEL_TYPE_CATBOOST",
+							"uri":  "https://storage.yandexcloud.net/" + bucketName + "/train.csv",
+						},
+						"standalone": {
+							"type": "ML_MODEL_TYPE_CATBOOST",
+							"uri":  "https://storage.yandexcloud.net/" + bucketName + "/train.csv",
+						},
+					}),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chMlModelResource),
+			{
+				Config: testAccMDBClickHouseMlModelConfig(chName, bucketName, rInt, "train2.csv"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chResourceSharded, "ml_model.#", "1"),
+					resource.TestCheckResourceAttr(chMlModelResource, "uri",
+						"https://storage.yandexcloud.net/"+bucketName+"/train2.csv"),
+					testAccCheckMDBClickHouseClusterHasMlModels(chResourceSharded, map[string]map[string]string{
+						"inline": {
+							"type": "ML_MODEL_TYPE_CATBOOST",
+							"uri":  "https://storage.yandexcloud.net/" + bucketName + "/train.csv",
+						},
+						"standalone": {
+							"type": "ML_MODEL_TYPE_CATBOOST",
+							"uri":  "https://storage.yandexcloud.net/" + bucketName + "/train2.csv",
+						},
+					}),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chMlModelResource),
+		},
+	})
+}
+
+func testAccMDBClickHouseMlModelConfig(name, bucket string, randInt int, modelFile string) string {
+	return fmt.Sprintf(clickHouseVPCDependencies+clickhouseObjectStorageDependencies(bucket, randInt)+`
+resource "yandex_storage_object" "test_ml_model2" {
+  bucket = yandex_storage_bucket.tmp_bucket.bucket
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+  key     = "train2.csv"
+  content = "a,b,c"
+}
+
+resource "yandex_mdb_clickhouse_cluster" "bar" {
+  depends_on = [
+    yandex_storage_object.test_ml_model,
+    yandex_storage_object.test_ml_model2
+  ]
+
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = "${yandex_vpc_network.mdb-ch-test-net.id}"
+
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  host {
+    type      = "CLICKHOUSE"
+    zone      = "ru-central1-a"
+    subnet_id = "${yandex_vpc_subnet.mdb-ch-test-subnet-a.id}"
+  }
+
+  ml_model {
+    name = "inline"
+    type = "ML_MODEL_TYPE_CATBOOST"
+    uri  = "https://storage.yandexcloud.net/${yandex_storage_bucket.tmp_bucket.bucket}/train.csv"
+  }
+}
+
+resource "yandex_mdb_clickhouse_ml_model" "standalone" {
+  cluster_id = yandex_mdb_clickhouse_cluster.bar.id
+  name       = "standalone"
+  type       = "ML_MODEL_TYPE_CATBOOST"
+  uri        = "https://storage.yandexcloud.net/${yandex_storage_bucket.tmp_bucket.bucket}/%s"
+}
+`, name, modelFile)
+}
@@ -0,0 +1,348 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBClickHouseShardCreateTimeout = 60 * time.Minute
+	yandexMDBClickHouseShardReadTimeout   = 1 * time.Minute
+	yandexMDBClickHouseShardUpdateTimeout = 60 * time.Minute
+	yandexMDBClickHouseShardDeleteTimeout = 30 * time.Minute
+)
+
+func resourceYandexMDBClickHouseShard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBClickHouseShardCreate,
+		Read:   resourceYandexMDBClickHouseShardRead,
+		Update: resourceYandexMDBClickHouseShardUpdate,
+		Delete: resourceYandexMDBClickHouseShardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBClickHouseShardCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBClickHouseShardReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBClickHouseShardUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBClickHouseShardDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_preset_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"disk_size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"disk_type_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"host": {
+				Type:     schema.TypeList,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"copy_schema": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseShardCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	shardName := d.Get("name").(string)
+
+	req := &clickhouse.AddClusterShardRequest{
+		ClusterId: clusterID,
+		ShardName: shardName,
+		ConfigSpec: &clickhouse.ShardConfigSpec{
+			Clickhouse: &clickhouse.ShardConfigSpec_Clickhouse{},
+		},
+		HostSpecs:  expandClickHouseShardHosts(d),
+		CopySchema: &wrappers.BoolValue{Value: d.Get("copy_schema").(bool)},
+	}
+	if _, ok := d.GetOk("resources"); ok {
+		req.ConfigSpec.Clickhouse.Resources = expandClickHouseResources(d, "resources.0")
+	}
+	if v, ok := d.GetOk("weight"); ok {
+		req.ConfigSpec.Clickhouse.Weight = &wrappers.Int64Value{Value: int64(v.(int))}
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().AddShard(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to add shard to ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	d.SetId(constructResourceId(clusterID, shardName))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while adding shard to ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("shard creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating ClickHouse shard %q", shardName)
+
+	return resourceYandexMDBClickHouseShardRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseShardRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, shardName, err := parseClickHouseClusterChildID(d.Id(), "shard")
+	if err != nil {
+		return err
+	}
+
+	shard, err := config.sdk.MDB().Clickhouse().Cluster().GetShard(ctx, &clickhouse.GetClusterShardRequest{
+		ClusterId: clusterID,
+		ShardName: shardName,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Shard %q", shardName))
+	}
+
+	hosts, err := listClickHouseHosts(ctx, config, clusterID)
+	if err != nil {
+		return err
+	}
+
+	var shardHosts []*clickhouse.Host
+	for _, h := range hosts {
+		if h.ShardName == shardName {
+			shardHosts = append(shardHosts, h)
+		}
+	}
+	shardHosts = filterClickHouseShardHosts(shardHosts, d.Get("host").([]interface{}))
+	shardHosts = sortClickHouseHosts(shardHosts, expandClickHouseShardHosts(d))
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", shard.Name)
+
+	chConfig := shard.GetConfig().GetClickhouse()
+	d.Set("weight", chConfig.GetWeight().GetValue())
+	if chConfig.GetResources() != nil {
+		resources, err := flattenClickHouseResources(chConfig.Resources)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("resources", resources); err != nil {
+			return err
+		}
+	}
+
+	return d.Set("host", flattenClickHouseShardHosts(shardHosts))
+}
+
+var mdbClickHouseShardUpdateFieldsMap = map[string]string{
+	"weight":    "config_spec.clickhouse.weight",
+	"resources": "config_spec.clickhouse.resources",
+}
+
+func resourceYandexMDBClickHouseShardUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	shardName := d.Get("name").(string)
+
+	var updatePath []string
+	for field, path := range mdbClickHouseShardUpdateFieldsMap {
+		if d.HasChange(field) {
+			updatePath = append(updatePath, path)
+		}
+	}
+
+	if len(updatePath) > 0 {
+		req := &clickhouse.UpdateClusterShardRequest{
+			ClusterId: clusterID,
+			ShardName: shardName,
+			ConfigSpec: &clickhouse.ShardConfigSpec{
+				Clickhouse: &clickhouse.ShardConfigSpec_Clickhouse{
+					Resources: expandClickHouseResources(d, "resources.0"),
+					Weight:    &wrappers.Int64Value{Value: int64(d.Get("weight").(int))},
+				},
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: updatePath},
+		}
+
+		op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().UpdateShard(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to update shard %q in ClickHouse Cluster %q: %s", shardName, clusterID, err)
+		}
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error while updating shard %q in ClickHouse Cluster %q: %s", shardName, clusterID, err)
+		}
+	}
+
+	if d.HasChange("host") {
+		if err := updateClickHouseShardHosts(d, meta); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating ClickHouse shard %q", shardName)
+	return resourceYandexMDBClickHouseShardRead(d, meta)
+}
+
+func updateClickHouseShardHosts(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	shardName := d.Get("name").(string)
+
+	hosts, err := listClickHouseHosts(ctx, config, clusterID)
+	if err != nil {
+		return err
+	}
+	currHosts := filterClickHouseHostsByShards(hosts, map[string]bool{shardName: true})
+	oldHosts, _ := d.GetChange("host")
+	currHosts = filterClickHouseShardHosts(currHosts, oldHosts.([]interface{}))
+	toDelete, toAdd := clickHouseHostsDiff(currHosts, expandClickHouseShardHosts(d))
+
+	if specs := toAdd[shardName]; len(specs) > 0 {
+		op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().AddHosts(ctx, &clickhouse.AddClusterHostsRequest{
+			ClusterId:  clusterID,
+			HostSpecs:  specs,
+			CopySchema: &wrappers.BoolValue{Value: d.Get("copy_schema").(bool)},
+		}))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to add hosts to shard %q in ClickHouse Cluster %q: %s", shardName, clusterID, err)
+		}
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error while adding hosts to shard %q in ClickHouse Cluster %q: %s", shardName, clusterID, err)
+		}
+	}
+
+	if fqdns := toDelete[shardName]; len(fqdns) > 0 {
+		op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().DeleteHosts(ctx, &clickhouse.DeleteClusterHostsRequest{
+			ClusterId: clusterID,
+			HostNames: fqdns,
+		}))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to delete hosts from shard %q in ClickHouse Cluster %q: %s", shardName, clusterID, err)
+		}
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error while deleting hosts from shard %q in ClickHouse Cluster %q: %s", shardName, clusterID, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceYandexMDBClickHouseShardDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	shardName := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Deleting ClickHouse shard %q", shardName)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().DeleteShard(ctx, &clickhouse.DeleteClusterShardRequest{
+		ClusterId: clusterID,
+		ShardName: shardName,
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse shard %q", shardName))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting shard %q from ClickHouse Cluster %q: %s", shardName, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting ClickHouse shard %q", shardName)
+	return nil
+}
+
+// Splits "<cluster_id>:<name>" id of the resources living inside of ClickHouse cluster.
+func parseClickHouseClusterChildID(id string, kind string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid %s resource id format: %q", kind, id)
+	}
+	return parts[0], parts[1], nil
+}
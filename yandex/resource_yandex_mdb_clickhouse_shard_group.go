@@ -0,0 +1,196 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBClickHouseShardGroupCreateTimeout = 10 * time.Minute
+	yandexMDBClickHouseShardGroupReadTimeout   = 1 * time.Minute
+	yandexMDBClickHouseShardGroupUpdateTimeout = 10 * time.Minute
+	yandexMDBClickHouseShardGroupDeleteTimeout = 10 * time.Minute
+)
+
+func resourceYandexMDBClickHouseShardGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBClickHouseShardGroupCreate,
+		Read:   resourceYandexMDBClickHouseShardGroupRead,
+		Update: resourceYandexMDBClickHouseShardGroupUpdate,
+		Delete: resourceYandexMDBClickHouseShardGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBClickHouseShardGroupCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBClickHouseShardGroupReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBClickHouseShardGroupUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBClickHouseShardGroupDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shard_names": {
+				Type:     schema.TypeList,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexMDBClickHouseShardGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	group := expandClickHouseShardGroup(map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"shard_names": d.Get("shard_names"),
+	})
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().CreateShardGroup(ctx, &clickhouse.CreateClusterShardGroupRequest{
+		ClusterId:      clusterID,
+		ShardGroupName: group.Name,
+		Description:    group.Description,
+		ShardNames:     group.ShardNames,
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to add shard group to ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	d.SetId(constructResourceId(clusterID, group.Name))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while adding shard group to ClickHouse Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("shard group creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating ClickHouse shard group %q", group.Name)
+
+	return resourceYandexMDBClickHouseShardGroupRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseShardGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, groupName, err := parseClickHouseClusterChildID(d.Id(), "shard group")
+	if err != nil {
+		return err
+	}
+
+	group, err := config.sdk.MDB().Clickhouse().Cluster().GetShardGroup(ctx, &clickhouse.GetClusterShardGroupRequest{
+		ClusterId:      clusterID,
+		ShardGroupName: groupName,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Shard group %q", groupName))
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	return d.Set("shard_names", group.ShardNames)
+}
+
+func resourceYandexMDBClickHouseShardGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	group := expandClickHouseShardGroup(map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"shard_names": d.Get("shard_names"),
+	})
+
+	var updatePath []string
+	for _, field := range []string{"description", "shard_names"} {
+		if d.HasChange(field) {
+			updatePath = append(updatePath, field)
+		}
+	}
+	if len(updatePath) == 0 {
+		return nil
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().UpdateShardGroup(ctx, &clickhouse.UpdateClusterShardGroupRequest{
+		ClusterId:      clusterID,
+		ShardGroupName: group.Name,
+		Description:    group.Description,
+		ShardNames:     group.ShardNames,
+		UpdateMask:     &field_mask.FieldMask{Paths: updatePath},
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update shard group %q in ClickHouse Cluster %q: %s", group.Name, clusterID, err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating shard group %q in ClickHouse Cluster %q: %s", group.Name, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished updating ClickHouse shard group %q", group.Name)
+	return resourceYandexMDBClickHouseShardGroupRead(d, meta)
+}
+
+func resourceYandexMDBClickHouseShardGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	groupName := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Deleting ClickHouse shard group %q", groupName)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().DeleteShardGroup(ctx, &clickhouse.DeleteClusterShardGroupRequest{
+		ClusterId:      clusterID,
+		ShardGroupName: groupName,
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse shard group %q", groupName))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting shard group %q from ClickHouse Cluster %q: %s", groupName, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting ClickHouse shard group %q", groupName)
+	return nil
+}
@@ -0,0 +1,93 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+)
+
+// Test that a shard group can be created, updated and imported outside of the ClickHouse Cluster resource
+func TestAccMDBClickHouseShardGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	var r clickhouse.Cluster
+	chName := acctest.RandomWithPrefix("tf-clickhouse-shard-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBClickHouseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseShardGroupConfig(chName, "test shard group", `"shard1", "shard2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterExists(chResourceSharded, &r, 2),
+					testAccCheckMDBClickHouseClusterHasShardGroups(&r, map[string][]string{
+						"test_group": {"shard1", "shard2"},
+					}),
+					resource.TestCheckResourceAttr(chResourceSharded, "shard_group.#", "0"),
+					resource.TestCheckResourceAttr(chShardGroupResource, "description", "test shard group"),
+					resource.TestCheckResourceAttr(chShardGroupResource, "shard_names.#", "2"),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chShardGroupResource),
+			{
+				Config: testAccMDBClickHouseShardGroupConfig(chName, "updated shard group", `"shard1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterExists(chResourceSharded, &r, 2),
+					testAccCheckMDBClickHouseClusterHasShardGroups(&r, map[string][]string{
+						"test_group": {"shard1"},
+					}),
+					resource.TestCheckResourceAttr(chResourceSharded, "shard_group.#", "0"),
+					resource.TestCheckResourceAttr(chShardGroupResource, "description", "updated shard group"),
+					resource.TestCheckResourceAttr(chShardGroupResource, "shard_names.#", "1"),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chShardGroupResource),
+		},
+	})
+}
+
+func testAccMDBClickHouseShardGroupConfig(name, description, shardNames string) string {
+	return fmt.Sprintf(clickHouseVPCDependencies+`
+resource "yandex_mdb_clickhouse_cluster" "bar" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = "${yandex_vpc_network.mdb-ch-test-net.id}"
+
+  embedded_keeper = true
+
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  host {
+    type       = "CLICKHOUSE"
+    zone       = "ru-central1-a"
+    subnet_id  = "${yandex_vpc_subnet.mdb-ch-test-subnet-a.id}"
+    shard_name = "shard1"
+  }
+
+  host {
+    type       = "CLICKHOUSE"
+    zone       = "ru-central1-b"
+    subnet_id  = "${yandex_vpc_subnet.mdb-ch-test-subnet-b.id}"
+    shard_name = "shard2"
+  }
+}
+
+resource "yandex_mdb_clickhouse_shard_group" "group" {
+  cluster_id  = yandex_mdb_clickhouse_cluster.bar.id
+  name        = "test_group"
+  description = "%s"
+  shard_names = [%s]
+}
+`, name, description, shardNames)
+}
@@ -0,0 +1,126 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+)
+
+const chShardResource = "yandex_mdb_clickhouse_shard.shard2"
+const chShardGroupResource = "yandex_mdb_clickhouse_shard_group.group"
+const chHostResource = "yandex_mdb_clickhouse_host.replica"
+
+// Test that shards, shard groups and hosts can be managed outside of the ClickHouse Cluster resource
+func TestAccMDBClickHouseShard_basic(t *testing.T) {
+	t.Parallel()
+
+	var r clickhouse.Cluster
+	chName := acctest.RandomWithPrefix("tf-clickhouse-shard")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBClickHouseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseShardConfig(chName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterExists(chResourceSharded, &r, 2),
+					testAccCheckMDBClickHouseClusterHasShards(&r, []string{"shard1", "shard2"}),
+					testAccCheckMDBClickHouseClusterHasShardGroups(&r, map[string][]string{
+						"test_group": {"shard1", "shard2"},
+					}),
+					resource.TestCheckResourceAttr(chResourceSharded, "host.#", "1"),
+					resource.TestCheckResourceAttr(chResourceSharded, "shard_group.#", "0"),
+					resource.TestCheckResourceAttr(chShardResource, "weight", "100"),
+					resource.TestCheckResourceAttr(chShardResource, "host.#", "1"),
+					resource.TestCheckResourceAttrSet(chShardResource, "host.0.fqdn"),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chShardResource, "copy_schema"),
+			mdbClickHouseClusterChildImportStep(chShardGroupResource),
+			{
+				Config: testAccMDBClickHouseShardConfig(chName, 2) + testAccMDBClickHouseHostConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseClusterExists(chResourceSharded, &r, 3),
+					resource.TestCheckResourceAttr(chResourceSharded, "host.#", "1"),
+					resource.TestCheckResourceAttr(chShardResource, "weight", "200"),
+					resource.TestCheckResourceAttr(chShardResource, "host.#", "1"),
+					resource.TestCheckResourceAttr(chHostResource, "shard_name", "shard2"),
+					resource.TestCheckResourceAttrSet(chHostResource, "fqdn"),
+				),
+			},
+			mdbClickHouseClusterChildImportStep(chHostResource, "copy_schema"),
+		},
+	})
+}
+
+func mdbClickHouseClusterChildImportStep(name string, ignore ...string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            name,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: ignore,
+	}
+}
+
+func testAccMDBClickHouseShardConfig(name string, weight int) string {
+	return fmt.Sprintf(clickHouseVPCDependencies+`
+resource "yandex_mdb_clickhouse_cluster" "bar" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = "${yandex_vpc_network.mdb-ch-test-net.id}"
+
+  embedded_keeper = true
+
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  host {
+    type       = "CLICKHOUSE"
+    zone       = "ru-central1-a"
+    subnet_id  = "${yandex_vpc_subnet.mdb-ch-test-subnet-a.id}"
+    shard_name = "shard1"
+  }
+}
+
+resource "yandex_mdb_clickhouse_shard" "shard2" {
+  cluster_id  = yandex_mdb_clickhouse_cluster.bar.id
+  name        = "shard2"
+  weight      = %d
+  copy_schema = true
+
+  host {
+    zone      = "ru-central1-b"
+    subnet_id = "${yandex_vpc_subnet.mdb-ch-test-subnet-b.id}"
+  }
+}
+
+resource "yandex_mdb_clickhouse_shard_group" "group" {
+  cluster_id  = yandex_mdb_clickhouse_cluster.bar.id
+  name        = "test_group"
+  description = "test shard group"
+  shard_names = ["shard1", yandex_mdb_clickhouse_shard.shard2.name]
+}
+`, name, weight*100)
+}
+
+const testAccMDBClickHouseHostConfig = `
+resource "yandex_mdb_clickhouse_host" "replica" {
+  cluster_id  = yandex_mdb_clickhouse_cluster.bar.id
+  zone        = "ru-central1-c"
+  subnet_id   = "${yandex_vpc_subnet.mdb-ch-test-subnet-c.id}"
+  shard_name  = "shard2"
+  copy_schema = true
+
+  depends_on = [yandex_mdb_clickhouse_shard.shard2]
+}
+`
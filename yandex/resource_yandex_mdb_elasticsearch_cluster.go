@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
@@ -260,6 +261,21 @@ func resourceYandexMDBElasticsearchCluster() *schema.Resource {
 					},
 				},
 			},
+			"restore": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -389,7 +405,14 @@ func resourceYandexMDBElasticsearchClusterCreate(d *schema.ResourceData, meta in
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().Create(ctx, req))
+	backupID, restore := d.GetOk("restore.0.backup_id")
+
+	var op *sdkoperation.Operation
+	if restore {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().Restore(ctx, prepareRestoreElasticsearchRequest(req, backupID.(string))))
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().Create(ctx, req))
+	}
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to create Elasticsearch Cluster: %s", err)
 	}
@@ -399,13 +422,15 @@ func resourceYandexMDBElasticsearchClusterCreate(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error while get Elasticsearch Cluster create operation metadata: %s", err)
 	}
 
-	md, ok := protoMetadata.(*elasticsearch.CreateClusterMetadata)
-	if !ok {
+	switch md := protoMetadata.(type) {
+	case *elasticsearch.CreateClusterMetadata:
+		d.SetId(md.ClusterId)
+	case *elasticsearch.RestoreClusterMetadata:
+		d.SetId(md.ClusterId)
+	default:
 		return fmt.Errorf("Could not get Elasticsearch Cluster ID from create operation metadata")
 	}
 
-	d.SetId(md.ClusterId)
-
 	err = op.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("Error while waiting for operation to create Elasticsearch Cluster: %s", err)
@@ -415,6 +440,18 @@ func resourceYandexMDBElasticsearchClusterCreate(d *schema.ResourceData, meta in
 		return fmt.Errorf("Elasticsearch Cluster creation failed: %s", err)
 	}
 
+	// Restore request does not accept maintenance window, so it is applied after the cluster is restored.
+	if restore && req.MaintenanceWindow != nil {
+		err = makeElasticsearchClusterUpdateRequest(&elasticsearch.UpdateClusterRequest{
+			ClusterId:         d.Id(),
+			MaintenanceWindow: req.MaintenanceWindow,
+			UpdateMask:        &field_mask.FieldMask{Paths: []string{"maintenance_window"}},
+		}, d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexMDBElasticsearchClusterRead(d, meta)
 }
 
@@ -476,6 +513,23 @@ func prepareCreateElasticsearchRequest(d *schema.ResourceData, meta *Config) (*e
 	return req, nil
 }
 
+func prepareRestoreElasticsearchRequest(req *elasticsearch.CreateClusterRequest, backupID string) *elasticsearch.RestoreClusterRequest {
+	return &elasticsearch.RestoreClusterRequest{
+		BackupId:           backupID,
+		Name:               req.Name,
+		Description:        req.Description,
+		Labels:             req.Labels,
+		Environment:        req.Environment,
+		ConfigSpec:         req.ConfigSpec,
+		HostSpecs:          req.HostSpecs,
+		NetworkId:          req.NetworkId,
+		SecurityGroupIds:   req.SecurityGroupIds,
+		ServiceAccountId:   req.ServiceAccountId,
+		DeletionProtection: req.DeletionProtection,
+		FolderId:           req.FolderId,
+	}
+}
+
 func resourceYandexMDBElasticsearchClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
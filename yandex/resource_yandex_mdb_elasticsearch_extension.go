@@ -0,0 +1,201 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+)
+
+const (
+	yandexMDBElasticsearchExtensionCreateTimeout = 15 * time.Minute
+	yandexMDBElasticsearchExtensionReadTimeout   = 1 * time.Minute
+	yandexMDBElasticsearchExtensionUpdateTimeout = 15 * time.Minute
+	yandexMDBElasticsearchExtensionDeleteTimeout = 15 * time.Minute
+)
+
+func resourceYandexMDBElasticsearchExtension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBElasticsearchExtensionCreate,
+		Read:   resourceYandexMDBElasticsearchExtensionRead,
+		Update: resourceYandexMDBElasticsearchExtensionUpdate,
+		Delete: resourceYandexMDBElasticsearchExtensionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBElasticsearchExtensionCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBElasticsearchExtensionReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBElasticsearchExtensionUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBElasticsearchExtensionDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Extension archive is uploaded on creation only, so a new URI means a new extension.
+			// API does not return the URI, so it stays empty for imported extensions and must not force replacement.
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"extension_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBElasticsearchExtensionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Extension().Create(ctx, &elasticsearch.CreateExtensionRequest{
+		ClusterId: clusterID,
+		Name:      name,
+		Uri:       d.Get("uri").(string),
+		Disabled:  !d.Get("active").(bool),
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create extension in Elasticsearch Cluster %q: %s", clusterID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while getting Elasticsearch extension create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*elasticsearch.CreateExtensionMetadata)
+	if !ok {
+		return fmt.Errorf("could not get extension ID from create operation metadata")
+	}
+
+	d.SetId(constructResourceId(clusterID, md.ExtensionId))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while creating extension %q in Elasticsearch Cluster %q: %s", name, clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("Elasticsearch extension creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating Elasticsearch extension %q", name)
+
+	return resourceYandexMDBElasticsearchExtensionRead(d, meta)
+}
+
+func resourceYandexMDBElasticsearchExtensionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, extensionID, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	extension, err := config.sdk.MDB().ElasticSearch().Extension().Get(ctx, &elasticsearch.GetExtensionRequest{
+		ClusterId:   clusterID,
+		ExtensionId: extensionID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Elasticsearch extension %q", extensionID))
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("extension_id", extension.Id)
+	d.Set("name", extension.Name)
+	d.Set("active", extension.Active)
+	return d.Set("version", extension.Version)
+}
+
+func resourceYandexMDBElasticsearchExtensionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID, extensionID, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Extension().Update(ctx, &elasticsearch.UpdateExtensionRequest{
+		ClusterId:   clusterID,
+		ExtensionId: extensionID,
+		Active:      d.Get("active").(bool),
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update extension %q in Elasticsearch Cluster %q: %s", extensionID, clusterID, err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating extension %q in Elasticsearch Cluster %q: %s", extensionID, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Elasticsearch extension %q", extensionID)
+	return resourceYandexMDBElasticsearchExtensionRead(d, meta)
+}
+
+func resourceYandexMDBElasticsearchExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID, extensionID, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Elasticsearch extension %q", extensionID)
+	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Extension().Delete(ctx, &elasticsearch.DeleteExtensionRequest{
+		ClusterId:   clusterID,
+		ExtensionId: extensionID,
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Elasticsearch extension %q", extensionID))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting extension %q from Elasticsearch Cluster %q: %s", extensionID, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting Elasticsearch extension %q", extensionID)
+	return nil
+}
@@ -0,0 +1,114 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+)
+
+const elasticsearchExtensionResource = "yandex_mdb_elasticsearch_extension.foo"
+
+// zip archive with a single synonyms.txt file
+const elasticsearchExtensionArchive = "UEsDBBQAAAAAAEGCU10QjUnjEQAAABEAAAAMAAAAc3lub255bXMudHh0dW5pdmVyc2UsIGNvc21vcwpQSwECFAMUAAAAAABBglNdEI1J4xEAAAARAAAADAAAAAAAAAAAAAAAgAEAAAAAc3lub255bXMudHh0UEsFBgAAAAABAAEAOgAAADsAAAAAAA=="
+
+func TestAccMDBElasticsearchExtension_basic(t *testing.T) {
+	t.Parallel()
+
+	esName := acctest.RandomWithPrefix("tf-elasticsearch-extension")
+	bucketName := acctest.RandomWithPrefix("tf-test-elasticsearch-bucket")
+	randInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBElasticsearchClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBElasticsearchExtensionConfig(esName, bucketName, randInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(elasticsearchExtensionResource, "name", "synonyms"),
+					resource.TestCheckResourceAttr(elasticsearchExtensionResource, "active", "true"),
+					resource.TestCheckResourceAttrSet(elasticsearchExtensionResource, "extension_id"),
+					resource.TestCheckResourceAttrSet(elasticsearchExtensionResource, "version"),
+					testAccCheckMDBElasticsearchExtensionActive(elasticsearchExtensionResource, true),
+				),
+			},
+			{
+				ResourceName:      elasticsearchExtensionResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// uri is not returned by API, so it stays empty after import
+				ImportStateVerifyIgnore: []string{"uri"},
+			},
+			{
+				Config: testAccMDBElasticsearchExtensionConfig(esName, bucketName, randInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(elasticsearchExtensionResource, "active", "false"),
+					testAccCheckMDBElasticsearchExtensionActive(elasticsearchExtensionResource, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMDBElasticsearchExtensionActive(n string, active bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterID, extensionID, err := deconstructResourceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		extension, err := config.sdk.MDB().ElasticSearch().Extension().Get(context.Background(), &elasticsearch.GetExtensionRequest{
+			ClusterId:   clusterID,
+			ExtensionId: extensionID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if extension.Active != active {
+			return fmt.Errorf("Expected extension %q active to be %t, got %t", extension.Name, active, extension.Active)
+		}
+		return nil
+	}
+}
+
+func testAccMDBElasticsearchExtensionConfig(name, bucket string, randInt int, active bool) string {
+	return testAccMDBElasticsearchClusterConfig(name, "Elasticsearch Cluster with extension", "PRESTABLE", false, randInt) + fmt.Sprintf(`
+resource "yandex_storage_bucket" "tmp_bucket" {
+  bucket = "%s"
+  acl    = "public-read"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+
+resource "yandex_storage_object" "synonyms" {
+  bucket = yandex_storage_bucket.tmp_bucket.bucket
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+  key            = "synonyms.zip"
+  content_base64 = "%s"
+}
+
+resource "yandex_mdb_elasticsearch_extension" "foo" {
+  cluster_id = yandex_mdb_elasticsearch_cluster.foo.id
+  name       = "synonyms"
+  uri        = "https://storage.yandexcloud.net/${yandex_storage_bucket.tmp_bucket.bucket}/${yandex_storage_object.synonyms.key}"
+  active     = %t
+}
+`, bucket, elasticsearchExtensionArchive, active)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 	"google.golang.org/genproto/googleapis/type/timeofday"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
@@ -268,6 +269,84 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 					},
 				},
 			},
+
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{"ANYTIME", "WEEKLY"}, false),
+							Required:     true,
+						},
+						"day": {
+							Type:         schema.TypeString,
+							ValidateFunc: greenplumMaintenanceWindowSchemaValidateFunc,
+							Optional:     true,
+						},
+						"hour": {
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntBetween(1, 24),
+							Optional:     true,
+						},
+					},
+				},
+			},
+
+			"greenplum_config": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbGreenplumSettingsFieldsInfo),
+				ValidateFunc:     generateMapSchemaValidateFunc(mdbGreenplumSettingsFieldsInfo),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"pooler_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pooling_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      greenplum.ConnectionPoolerConfig_SESSION.String(),
+							ValidateFunc: validateParsableValue(parseGreenplumPoolingMode),
+						},
+						"pool_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"pool_client_idle_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"restore": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -281,7 +360,13 @@ func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interf
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Create(ctx, req))
+	var op *sdkoperation.Operation
+	backupID, restore := d.GetOk("restore.0.backup_id")
+	if restore {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Restore(ctx, prepareRestoreGreenplumRequest(d, req, backupID.(string))))
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Create(ctx, req))
+	}
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to create Greenplum Cluster: %s", err)
 	}
@@ -289,11 +374,14 @@ func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return fmt.Errorf("Error while get Greenplum create operation metadata: %s", err)
 	}
-	md, ok := protoMetadata.(*greenplum.CreateClusterMetadata)
-	if !ok {
+	switch md := protoMetadata.(type) {
+	case *greenplum.CreateClusterMetadata:
+		d.SetId(md.ClusterId)
+	case *greenplum.RestoreClusterMetadata:
+		d.SetId(md.ClusterId)
+	default:
 		return fmt.Errorf("Could not get Greenplum Cluster ID from create operation metadata")
 	}
-	d.SetId(md.ClusterId)
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -302,6 +390,20 @@ func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interf
 	if _, err := op.Response(); err != nil {
 		return fmt.Errorf("Greenplum Cluster creation failed: %s", err)
 	}
+
+	// Restore request does not accept config spec, so greenplum_config and pooler_config are applied after the cluster is restored.
+	if restore {
+		if updateReq := prepareGreenplumConfigUpdateAfterRestore(d, req.ConfigSpec); updateReq != nil {
+			op, err := config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Update(ctx, updateReq))
+			if err != nil {
+				return fmt.Errorf("Error while requesting API to update config of restored Greenplum Cluster %q: %s", d.Id(), err)
+			}
+			if err := op.Wait(ctx); err != nil {
+				return fmt.Errorf("Error while updating config of restored Greenplum Cluster %q: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceYandexMDBGreenplumClusterRead(d, meta)
 }
 
@@ -330,6 +432,16 @@ func prepareCreateGreenplumRequest(d *schema.ResourceData, meta *Config) (*green
 		return nil, fmt.Errorf("Error while expanding network id on Greenplum Cluster create: %s", err)
 	}
 
+	maintenanceWindow, err := expandGreenplumMaintenanceWindow(d)
+	if err != nil {
+		return nil, fmt.Errorf("Error while expanding maintenance window on Greenplum Cluster create: %s", err)
+	}
+
+	configSpec, err := expandGreenplumConfigSpec(d)
+	if err != nil {
+		return nil, fmt.Errorf("Error while expanding config spec on Greenplum Cluster create: %s", err)
+	}
+
 	req := greenplum.CreateClusterRequest{
 		FolderId:         folderID,
 		Name:             d.Get("name").(string),
@@ -366,10 +478,57 @@ func prepareCreateGreenplumRequest(d *schema.ResourceData, meta *Config) (*green
 
 		UserName:     d.Get("user_name").(string),
 		UserPassword: d.Get("user_password").(string),
+
+		MaintenanceWindow: maintenanceWindow,
+		ConfigSpec:        configSpec,
 	}
 	return &req, nil
 }
 
+// Segment layout and user credentials of the restored cluster are taken from the backup.
+func prepareRestoreGreenplumRequest(d *schema.ResourceData, req *greenplum.CreateClusterRequest, backupID string) *greenplum.RestoreClusterRequest {
+	return &greenplum.RestoreClusterRequest{
+		BackupId:    backupID,
+		FolderId:    req.FolderId,
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Environment: req.Environment,
+		Config: &greenplum.GreenplumRestoreConfig{
+			BackupWindowStart: expandGreenplumBackupWindowStart(d),
+			Access:            expandGreenplumAccess(d),
+			ZoneId:            req.Config.ZoneId,
+			SubnetId:          req.Config.SubnetId,
+			AssignPublicIp:    req.Config.AssignPublicIp,
+		},
+		MasterResources:    req.MasterConfig.Resources,
+		SegmentResources:   req.SegmentConfig.Resources,
+		NetworkId:          req.NetworkId,
+		SecurityGroupIds:   req.SecurityGroupIds,
+		DeletionProtection: d.Get("deletion_protection").(bool),
+		MaintenanceWindow:  req.MaintenanceWindow,
+	}
+}
+
+func prepareGreenplumConfigUpdateAfterRestore(d *schema.ResourceData, configSpec *greenplum.ConfigSpec) *greenplum.UpdateClusterRequest {
+	var paths []string
+	if configSpec.GetPool() != nil {
+		paths = append(paths, "config_spec.pool")
+	}
+	if configSpec.GetGreenplumConfig() != nil {
+		paths = append(paths, "config_spec."+getGreenplumConfigFieldName(d.Get("version").(string)))
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return &greenplum.UpdateClusterRequest{
+		ClusterId:  d.Id(),
+		ConfigSpec: configSpec,
+		UpdateMask: &field_mask.FieldMask{Paths: paths},
+	}
+}
+
 func resourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -475,6 +634,26 @@ func resourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("backup_window_start", []map[string]interface{}{bwsElement})
 
+	maintenanceWindow, err := flattenGreenplumMaintenanceWindow(cluster.MaintenanceWindow)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
+		return err
+	}
+
+	greenplumConfig, err := flattenGreenplumClusterConfig(cluster.ClusterConfig)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("greenplum_config", greenplumConfig); err != nil {
+		return err
+	}
+
+	if err := d.Set("pooler_config", flattenGreenplumPoolerConfig(cluster.GetClusterConfig().GetPool().GetUserConfig())); err != nil {
+		return err
+	}
+
 	return d.Set("created_at", getTimestamp(cluster.CreatedAt))
 }
 
@@ -535,6 +714,8 @@ var mdbGreenplumUpdateFieldsMap = map[string]string{
 	"access.0.web_sql":    "config.access.web_sql",
 	"backup_window_start": "config.backup_window_start",
 	"deletion_protection": "deletion_protection",
+	"maintenance_window":  "maintenance_window",
+	"pooler_config":       "config_spec.pool",
 }
 
 func resourceYandexMDBGreenplumClusterUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -560,6 +741,10 @@ func resourceYandexMDBGreenplumClusterUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("greenplum_config") {
+		updatePath = append(updatePath, "config_spec."+getGreenplumConfigFieldName(d.Get("version").(string)))
+	}
+
 	if len(updatePath) == 0 {
 		return nil
 	}
@@ -589,12 +774,24 @@ func getGreenplumlusterUpdateRequest(d *schema.ResourceData) (*greenplum.UpdateC
 		return nil, fmt.Errorf("error expanding labels while updating Greenplum cluster: %s", err)
 	}
 
+	maintenanceWindow, err := expandGreenplumMaintenanceWindow(d)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding maintenance_window while updating Greenplum cluster: %s", err)
+	}
+
+	configSpec, err := expandGreenplumConfigSpec(d)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding greenplum_config while updating Greenplum cluster: %s", err)
+	}
+
 	req := &greenplum.UpdateClusterRequest{
 		ClusterId:          d.Id(),
 		Name:               d.Get("name").(string),
 		Description:        d.Get("description").(string),
 		Labels:             labels,
 		DeletionProtection: d.Get("deletion_protection").(bool),
+		MaintenanceWindow:  maintenanceWindow,
+		ConfigSpec:         configSpec,
 	}
 
 	return req, nil
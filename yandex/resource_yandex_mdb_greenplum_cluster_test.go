@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
@@ -122,6 +124,14 @@ func TestAccMDBGreenplumCluster_full(t *testing.T) {
 					resource.TestCheckResourceAttr(greenplumResource, "security_group_ids.#", "1"),
 					resource.TestCheckResourceAttr(greenplumResource, "access.0.data_lens", "true"),
 					resource.TestCheckResourceAttr(greenplumResource, "backup_window_start.0.minutes", "15"),
+					resource.TestCheckResourceAttr(greenplumResource, "maintenance_window.0.type", "WEEKLY"),
+					resource.TestCheckResourceAttr(greenplumResource, "maintenance_window.0.day", "SAT"),
+					resource.TestCheckResourceAttr(greenplumResource, "maintenance_window.0.hour", "12"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.max_connections", "395"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.gp_workfile_compression", "false"),
+					resource.TestCheckResourceAttr(greenplumResource, "pooler_config.0.pooling_mode", "TRANSACTION"),
+					resource.TestCheckResourceAttr(greenplumResource, "pooler_config.0.pool_size", "10"),
+					resource.TestCheckResourceAttr(greenplumResource, "pooler_config.0.pool_client_idle_timeout", "4"),
 				),
 			},
 			mdbGreenplumClusterImportStep(greenplumResource),
@@ -335,6 +345,23 @@ resource "yandex_mdb_greenplum_cluster" "foo" {
     minutes = 15
   }
 
+  maintenance_window {
+    type = "WEEKLY"
+    day  = "SAT"
+    hour = 12
+  }
+
+  greenplum_config = {
+    max_connections         = 395
+    gp_workfile_compression = "false"
+  }
+
+  pooler_config {
+    pooling_mode             = "TRANSACTION"
+    pool_size                = 10
+    pool_client_idle_timeout = 4
+  }
+
   user_name     = "user1"
   user_password = "mysecurepassword"
 
@@ -344,3 +371,35 @@ resource "yandex_mdb_greenplum_cluster" "foo" {
 }
 `, name, desc, environment, deletionProtection)
 }
+
+func TestPrepareGreenplumConfigUpdateAfterRestore(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]interface{}{
+		"version": "6.19",
+		"greenplum_config": map[string]interface{}{
+			"max_connections": "395",
+		},
+		"pooler_config": []interface{}{
+			map[string]interface{}{
+				"pooling_mode": "TRANSACTION",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBGreenplumCluster().Schema, raw)
+	d.SetId("cid")
+
+	spec, err := expandGreenplumConfigSpec(d)
+	require.NoError(t, err)
+
+	req := prepareGreenplumConfigUpdateAfterRestore(d, spec)
+	require.NotNil(t, req)
+	require.Equal(t, "cid", req.ClusterId)
+	require.Equal(t, spec, req.ConfigSpec)
+	require.Equal(t, []string{"config_spec.pool", "config_spec.greenplum_config_6_19"}, req.UpdateMask.GetPaths())
+
+	d = schema.TestResourceDataRaw(t, resourceYandexMDBGreenplumCluster().Schema, map[string]interface{}{"version": "6.19"})
+	spec, err = expandGreenplumConfigSpec(d)
+	require.NoError(t, err)
+	require.Nil(t, prepareGreenplumConfigUpdateAfterRestore(d, spec))
+}
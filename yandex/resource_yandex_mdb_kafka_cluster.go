@@ -30,7 +30,7 @@ func resourceYandexMDBKafkaCluster() *schema.Resource {
 		Update: resourceYandexMDBKafkaClusterUpdate,
 		Delete: resourceYandexMDBKafkaClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexMDBKafkaClusterImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -90,7 +90,7 @@ func resourceYandexMDBKafkaCluster() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Set:      kafkaUserHash,
-				Elem:     resourceYandexMDBKafkaUser(),
+				Elem:     resourceYandexMDBKafkaClusterUserBlock(),
 			},
 			"folder_id": {
 				Type:     schema.TypeString,
@@ -282,7 +282,7 @@ func resourceYandexMDBKafkaClusterTopicBlock() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBKafkaUser() *schema.Resource {
+func resourceYandexMDBKafkaClusterUserBlock() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -712,6 +712,8 @@ func resourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	// Users absent from state are managed by yandex_mdb_kafka_user resources.
+	users = filterKafkaUsers(users, passwords)
 	if err := d.Set("user", flattenKafkaUsers(users, passwords)); err != nil {
 		return err
 	}
@@ -762,7 +764,8 @@ func resourceYandexMDBKafkaClusterUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if d.HasChange("user") {
-		if err := updateKafkaClusterUsers(d, meta); err != nil {
+		userModifier := NewKafkaUserManager(meta.(*Config))
+		if err := updateKafkaClusterUsers(d, userModifier); err != nil {
 			return err
 		}
 	}
@@ -825,6 +828,25 @@ func listKafkaTopics(ctx context.Context, config *Config, id string) ([]*kafka.T
 	return ret, nil
 }
 
+// resourceYandexMDBKafkaClusterImportState puts all users of the cluster into state, so that
+// they are managed by the imported resource. Users that are not in state are skipped on read.
+func resourceYandexMDBKafkaClusterImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	users, err := listKafkaUsers(ctx, config, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("user", flattenKafkaUsers(users, nil)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func listKafkaUsers(ctx context.Context, config *Config, id string) ([]*kafka.User, error) {
 	ret := []*kafka.User{}
 	pageToken := ""
@@ -1095,42 +1117,47 @@ func updateKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 	return nil
 }
 
-func updateKafkaClusterUsers(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+// Only users that were declared in the inline user block are deleted here, so users created
+// with yandex_mdb_kafka_user resources are left untouched.
+func updateKafkaClusterUsers(d *schema.ResourceData, userModifier KafkaUserModifier) error {
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
-	currUsers, err := listKafkaUsers(ctx, config, d.Id())
+	currUsers, err := userModifier.ListKafkaUsers(ctx, d)
 	if err != nil {
 		return err
 	}
 
-	targetUsers, err := expandKafkaUsers(d)
+	oldSpecs, newSpecs := d.GetChange("user")
+	oldUsers, err := expandKafkaUserSet(oldSpecs.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	managedUsers := kafkaUsersPasswords(oldUsers)
+
+	targetUsers, err := expandKafkaUserSet(newSpecs.(*schema.Set))
 	if err != nil {
 		return err
 	}
 	toDelete, toAdd := kafkaUsersDiff(currUsers, targetUsers)
 
 	for _, user := range toDelete {
-		err := deleteKafkaUser(ctx, config, d, user)
+		if _, ok := managedUsers[user]; !ok {
+			continue
+		}
+		err := userModifier.DeleteKafkaUser(ctx, d, user)
 		if err != nil {
 			return err
 		}
 	}
 	for _, user := range toAdd {
-		err := createKafkaUser(ctx, config, d, user)
+		err := userModifier.CreateKafkaUser(ctx, d, user)
 		if err != nil {
 			return err
 		}
 	}
 
-	oldSpecs, newSpecs := d.GetChange("user")
-	err = updateKafkaUsers(ctx, config, d, oldSpecs.(*schema.Set), newSpecs.(*schema.Set))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return updateKafkaUsers(ctx, userModifier, d, oldSpecs.(*schema.Set), newSpecs.(*schema.Set))
 }
 
 func deleteKafkaUser(ctx context.Context, config *Config, d *schema.ResourceData, userName string) error {
@@ -1177,7 +1204,7 @@ func createKafkaUser(ctx context.Context, config *Config, d *schema.ResourceData
 	return nil
 }
 
-func updateKafkaUsers(ctx context.Context, config *Config, d *schema.ResourceData, oldSpecs *schema.Set, newSpecs *schema.Set) error {
+func updateKafkaUsers(ctx context.Context, userModifier KafkaUserModifier, d *schema.ResourceData, oldSpecs *schema.Set, newSpecs *schema.Set) error {
 	m := map[string]*kafka.UserSpec{}
 	for _, spec := range oldSpecs.List() {
 		user, err := expandKafkaUser(spec.(map[string]interface{}))
@@ -1210,7 +1237,7 @@ func updateKafkaUsers(ctx context.Context, config *Config, d *schema.ResourceDat
 					Permissions: user.Permissions,
 					UpdateMask:  &field_mask.FieldMask{Paths: updatePaths},
 				}
-				err = updateKafkaUser(ctx, config, d, req)
+				err = userModifier.UpdateKafkaUser(ctx, d, req)
 				if err != nil {
 					return err
 				}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -117,6 +118,34 @@ func mdbKafkaClusterImportStep(name string) resource.TestStep {
 	}
 }
 
+// mdbKafkaClusterImportUsersStep checks that users of the cluster are put into state on import,
+// so that they are not created again on the next apply
+func mdbKafkaClusterImportUsersStep(name string, users ...string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName: name,
+		ImportState:  true,
+		ImportStateCheck: func(s []*terraform.InstanceState) error {
+			if len(s) != 1 {
+				return fmt.Errorf("expected one InstanceState, found: %d", len(s))
+			}
+
+			var imported []string
+			for k, v := range s[0].Attributes {
+				if strings.HasPrefix(k, "user.") && strings.HasSuffix(k, ".name") {
+					imported = append(imported, v)
+				}
+			}
+
+			sort.Strings(users)
+			sort.Strings(imported)
+			if fmt.Sprintf("%v", users) != fmt.Sprintf("%v", imported) {
+				return fmt.Errorf("expected imported users %v, got %v", users, imported)
+			}
+			return nil
+		},
+	}
+}
+
 func TestExpandKafkaClusterConfig(t *testing.T) {
 	raw := map[string]interface{}{
 		"folder_id":   "",
@@ -829,6 +858,92 @@ func TestUpdateKafkaClusterTopics(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpdateKafkaClusterUsers(t *testing.T) {
+	rawInitial := map[string]interface{}{
+		"user": []interface{}{
+			map[string]interface{}{"name": "deletedUser", "password": "password"},
+			map[string]interface{}{"name": "sameUser", "password": "password"},
+			map[string]interface{}{"name": "updatedUser", "password": "password"},
+			map[string]interface{}{"name": "lostUser", "password": "password"},
+		},
+	}
+	rawTarget := map[string]interface{}{
+		"user": []interface{}{
+			map[string]interface{}{"name": "sameUser", "password": "password"},
+			map[string]interface{}{"name": "lostUser", "password": "password"},
+			map[string]interface{}{"name": "existingUser", "password": "password"},
+			map[string]interface{}{
+				"name":     "updatedUser",
+				"password": "password",
+				"permission": []interface{}{
+					map[string]interface{}{"topic_name": "events", "role": "ACCESS_ROLE_CONSUMER"},
+				},
+			},
+			map[string]interface{}{"name": "newUser", "password": "newPassword"},
+		},
+	}
+
+	clusterSchema := resourceYandexMDBKafkaCluster().Schema
+	emptyState := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 1)
+	targetDiff, err := schema.InternalMap(clusterSchema).Diff(context.Background(), emptyState, terraform.NewResourceConfigRaw(rawTarget), nil, nil, true)
+	require.NoError(t, err)
+	resourceData := CreateResourceData(t, clusterSchema, rawInitial, targetDiff.Attributes)
+
+	ctrl := gomock.NewController(t)
+	userModifier := mocks.NewMockKafkaUserModifier(ctrl)
+	// lostUser was deleted outside of Terraform, existingUser was created before import,
+	// and standaloneUser is managed by yandex_mdb_kafka_user resource
+	userModifier.EXPECT().ListKafkaUsers(gomock.Any(), resourceData).Return([]*kafka.User{
+		{Name: "deletedUser"},
+		{Name: "sameUser"},
+		{Name: "updatedUser"},
+		{Name: "existingUser"},
+		{Name: "standaloneUser"},
+	}, nil).Times(1)
+	userModifier.EXPECT().DeleteKafkaUser(gomock.Any(), resourceData, "deletedUser").Return(nil).Times(1)
+	var createdUsers []string
+	userModifier.EXPECT().CreateKafkaUser(gomock.Any(), resourceData, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, d *schema.ResourceData, userSpec *kafka.UserSpec) error {
+			if userSpec.Name == "newUser" {
+				require.Equal(t, (&kafka.UserSpec{
+					Name:        "newUser",
+					Password:    "newPassword",
+					Permissions: []*kafka.Permission{},
+				}).String(), userSpec.String())
+			}
+			createdUsers = append(createdUsers, userSpec.Name)
+			return nil
+		}).Times(2)
+	userModifier.EXPECT().UpdateKafkaUser(gomock.Any(), resourceData, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, d *schema.ResourceData, req *kafka.UpdateUserRequest) error {
+			require.Equal(t, "updatedUser", req.UserName)
+			require.Equal(t, []string{"permissions"}, req.UpdateMask.Paths)
+			require.Equal(t, (&kafka.Permission{
+				TopicName: "events",
+				Role:      kafka.Permission_ACCESS_ROLE_CONSUMER,
+			}).String(), req.Permissions[0].String())
+			return nil
+		}).Times(1)
+
+	err = updateKafkaClusterUsers(resourceData, userModifier)
+
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"newUser", "lostUser"}, createdUsers)
+}
+
+func TestFilterKafkaUsers(t *testing.T) {
+	users := []*kafka.User{
+		{Name: "inlineUser"},
+		{Name: "standaloneUser"},
+	}
+	passwords := map[string]string{"inlineUser": "password"}
+
+	filtered := filterKafkaUsers(users, passwords)
+
+	require.Len(t, filtered, 1)
+	require.Equal(t, "inlineUser", filtered[0].Name)
+}
+
 // Test that a Kafka Cluster can be created, updated and destroyed in single zone mode
 func TestAccMDBKafkaCluster_single(t *testing.T) {
 	t.Parallel()
@@ -893,6 +1008,7 @@ func TestAccMDBKafkaCluster_single(t *testing.T) {
 					testAccCheckCreatedAtAttr(kfResource),
 				),
 			},
+			mdbKafkaClusterImportUsersStep(kfResource, "alice", "charlie"),
 		},
 	})
 }
@@ -953,6 +1069,7 @@ func TestAccMDBKafkaCluster_HA(t *testing.T) {
 					testAccCheckCreatedAtAttr(kfResource),
 				),
 			},
+			mdbKafkaClusterImportUsersStep(kfResource, "alice", "charlie"),
 		},
 	})
 }
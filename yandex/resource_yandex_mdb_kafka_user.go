@@ -0,0 +1,219 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBKafkaUserCreateTimeout = 10 * time.Minute
+	yandexMDBKafkaUserReadTimeout   = 1 * time.Minute
+	yandexMDBKafkaUserUpdateTimeout = 10 * time.Minute
+	yandexMDBKafkaUserDeleteTimeout = 10 * time.Minute
+)
+
+func resourceYandexMDBKafkaUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBKafkaUserCreate,
+		Read:   resourceYandexMDBKafkaUserRead,
+		Update: resourceYandexMDBKafkaUserUpdate,
+		Delete: resourceYandexMDBKafkaUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaUserCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaUserReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaUserUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaUserDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      kafkaUserPermissionHash,
+				Elem:     resourceYandexMDBKafkaPermission(),
+			},
+		},
+	}
+}
+
+func resourceYandexMDBKafkaUserCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	userSpec, err := expandKafkaUser(map[string]interface{}{
+		"name":       d.Get("name"),
+		"password":   d.Get("password"),
+		"permission": d.Get("permission"),
+	})
+	if err != nil {
+		return err
+	}
+
+	req := &kafka.CreateUserRequest{
+		ClusterId: d.Get("cluster_id").(string),
+		UserSpec:  userSpec,
+	}
+
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Creating Kafka user %q within cluster %q", userSpec.Name, req.ClusterId)
+		return config.sdk.MDB().Kafka().User().Create(ctx, req)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create Kafka user: %s", err)
+	}
+
+	d.SetId(constructResourceId(req.ClusterId, userSpec.Name))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while waiting for Kafka user create operation: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("kafka user creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating Kafka user %q", userSpec.Name)
+
+	return resourceYandexMDBKafkaUserRead(d, meta)
+}
+
+func resourceYandexMDBKafkaUserRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid user resource id format: %q", d.Id())
+	}
+
+	clusterID := parts[0]
+	userName := parts[1]
+	user, err := config.sdk.MDB().Kafka().User().Get(ctx, &kafka.GetUserRequest{
+		ClusterId: clusterID,
+		UserName:  userName,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("User %q", userName))
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", user.Name)
+	return d.Set("permission", flattenKafkaUserPermissions(user.Permissions))
+}
+
+func resourceYandexMDBKafkaUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	userName := d.Get("name").(string)
+
+	permissions, err := expandKafkaPermissions(d.Get("permission").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	request := &kafka.UpdateUserRequest{
+		ClusterId:   clusterID,
+		UserName:    userName,
+		Password:    d.Get("password").(string),
+		Permissions: permissions,
+	}
+
+	var updatePath []string
+	for field, path := range mdbKafkaUserUpdateFieldsMap {
+		if d.HasChange(field) {
+			updatePath = append(updatePath, path)
+		}
+	}
+	if len(updatePath) == 0 {
+		return nil
+	}
+	request.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
+
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Kafka user update request: %+v", request.UpdateMask)
+		return config.sdk.MDB().Kafka().User().Update(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update user %q in Kafka Cluster %q: %s",
+			userName, clusterID, err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating user in Kafka Cluster %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Kafka user %q", userName)
+	return resourceYandexMDBKafkaUserRead(d, meta)
+}
+
+var mdbKafkaUserUpdateFieldsMap = map[string]string{
+	"password":   "password",
+	"permission": "permissions",
+}
+
+func resourceYandexMDBKafkaUserDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	userName := d.Get("name").(string)
+	clusterID := d.Get("cluster_id").(string)
+	request := &kafka.DeleteUserRequest{
+		ClusterId: clusterID,
+		UserName:  userName,
+	}
+
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Deleting Kafka user %q", userName)
+		return config.sdk.MDB().Kafka().User().Delete(ctx, request)
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Kafka user %q", userName))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting user %q from Kafka Cluster %q: %s", userName, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting Kafka user %q", userName)
+	return nil
+}
@@ -0,0 +1,195 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAccMDBKafkaUser(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaUserConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserHasPermissions("alice", map[string]kafka.Permission_AccessRole{
+						"events": kafka.Permission_ACCESS_ROLE_PRODUCER,
+					}),
+					testAccCheckMDBKafkaClusterHasUser("bob"),
+					testAccCheckMDBKafkaClusterHasUser("inline"),
+					resource.TestCheckResourceAttr(kafkaClusterResourceName, "user.#", "1"),
+				),
+			},
+			mdbKafkaUserImportStep("yandex_mdb_kafka_user.alice"),
+			{
+				Config: testAccMDBKafkaUserConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserHasPermissions("alice", map[string]kafka.Permission_AccessRole{
+						"events":       kafka.Permission_ACCESS_ROLE_CONSUMER,
+						"transactions": kafka.Permission_ACCESS_ROLE_PRODUCER,
+					}),
+					testAccCheckMDBKafkaClusterDoesNotHaveUser("bob"),
+					testAccCheckMDBKafkaClusterHasUser("inline"),
+					resource.TestCheckResourceAttr(kafkaClusterResourceName, "user.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func mdbKafkaUserImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            name,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"password"}, // passwords are not returned
+	}
+}
+
+func testAccMDBKafkaUserConfigStep0(name string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster" "foo" {
+	name        = "%s"
+	description = "Kafka User Terraform Test"
+	environment = "PRODUCTION"
+	network_id  = yandex_vpc_network.mdb-kafka-test-net.id
+	subnet_ids = [yandex_vpc_subnet.mdb-kafka-test-subnet-a.id]
+
+	config {
+	  version          = "2.8"
+	  brokers_count    = 1
+	  zones            = ["ru-central1-a"]
+	  unmanaged_topics = true
+	  kafka {
+		resources {
+		  resource_preset_id = "s2.micro"
+		  disk_type_id       = "network-hdd"
+		  disk_size          = 16
+		}
+	  }
+	}
+
+	user {
+	  name     = "inline"
+	  password = "password"
+	}
+}
+
+resource "yandex_mdb_kafka_topic" events {
+  cluster_id         = yandex_mdb_kafka_cluster.foo.id
+  name               = "events"
+  partitions         = 1
+  replication_factor = 1
+}
+
+resource "yandex_mdb_kafka_topic" transactions {
+  cluster_id         = yandex_mdb_kafka_cluster.foo.id
+  name               = "transactions"
+  partitions         = 1
+  replication_factor = 1
+}
+`, name)
+}
+
+func testAccMDBKafkaUserConfigStep1(name string) string {
+	return testAccMDBKafkaUserConfigStep0(name) + `
+resource "yandex_mdb_kafka_user" alice {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "alice"
+  password   = "password"
+  permission {
+    topic_name = yandex_mdb_kafka_topic.events.name
+    role       = "ACCESS_ROLE_PRODUCER"
+  }
+}
+
+resource "yandex_mdb_kafka_user" bob {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "bob"
+  password   = "password"
+}
+`
+}
+
+func testAccMDBKafkaUserConfigStep2(name string) string {
+	return testAccMDBKafkaUserConfigStep0(name) + `
+resource "yandex_mdb_kafka_user" alice {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "alice"
+  password   = "new_password"
+  permission {
+    topic_name = yandex_mdb_kafka_topic.events.name
+    role       = "ACCESS_ROLE_CONSUMER"
+  }
+  permission {
+    topic_name = yandex_mdb_kafka_topic.transactions.name
+    role       = "ACCESS_ROLE_PRODUCER"
+  }
+}
+`
+}
+
+func testAccLoadKafkaUser(s *terraform.State, userName string) (*kafka.User, error) {
+	rs, ok := s.RootModule().Resources[kafkaClusterResourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found", kafkaClusterResourceName)
+	}
+
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no ID is set")
+	}
+
+	config := testAccProvider.Meta().(*Config)
+	return config.sdk.MDB().Kafka().User().Get(context.Background(), &kafka.GetUserRequest{
+		ClusterId: rs.Primary.ID,
+		UserName:  userName,
+	})
+}
+
+func testAccCheckMDBKafkaClusterHasUser(userName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testAccLoadKafkaUser(s, userName)
+		return err
+	}
+}
+
+func testAccCheckMDBKafkaClusterDoesNotHaveUser(userName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testAccLoadKafkaUser(s, userName)
+		if err == nil {
+			return fmt.Errorf("expected user %q to be absent but it exists", userName)
+		}
+		if !isStatusWithCode(err, codes.NotFound) {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckMDBKafkaUserHasPermissions(userName string, expected map[string]kafka.Permission_AccessRole) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := testAccLoadKafkaUser(s, userName)
+		if err != nil {
+			return err
+		}
+		if len(user.Permissions) != len(expected) {
+			return fmt.Errorf("user %q has %d permissions, expected: %d", userName, len(user.Permissions), len(expected))
+		}
+		for _, p := range user.Permissions {
+			if role, ok := expected[p.TopicName]; !ok || role != p.Role {
+				return fmt.Errorf("user %q has unexpected permission %v on topic %q", userName, p.Role, p.TopicName)
+			}
+		}
+		return nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 )
 
 func resourceYandexMDBMongodbCluster() *schema.Resource {
@@ -378,6 +379,27 @@ func resourceYandexMDBMongodbCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restore": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: stringToTimeValidateFunc,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -460,6 +482,33 @@ func prepareCreateMongodbRequest(d *schema.ResourceData, meta *Config) (*mongodb
 	return &req, nil
 }
 
+func prepareRestoreMongodbRequest(d *schema.ResourceData, req *mongodb.CreateClusterRequest, backupID string) (*mongodb.RestoreClusterRequest, error) {
+	restoreReq := &mongodb.RestoreClusterRequest{
+		BackupId:         backupID,
+		Name:             req.Name,
+		Description:      req.Description,
+		Labels:           req.Labels,
+		Environment:      req.Environment,
+		ConfigSpec:       req.ConfigSpec,
+		HostSpecs:        req.HostSpecs,
+		NetworkId:        req.NetworkId,
+		FolderId:         req.FolderId,
+		SecurityGroupIds: req.SecurityGroupIds,
+	}
+
+	if backupTime, ok := d.GetOk("restore.0.time"); ok {
+		t, err := parseStringToTime(backupTime.(string))
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing restore.0.time to create MongoDB Cluster from backup %v, value: %v error: %s", backupID, backupTime, err)
+		}
+		restoreReq.RecoveryTargetSpec = &mongodb.RestoreClusterRequest_RecoveryTargetSpec{
+			Timestamp: t.Unix(),
+		}
+	}
+
+	return restoreReq, nil
+}
+
 func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	req, err := prepareCreateMongodbRequest(d, config)
@@ -468,7 +517,19 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().MongoDB().Cluster().Create(ctx, req))
+	backupID, restore := d.GetOk("restore.0.backup_id")
+
+	var op *sdkoperation.Operation
+	if restore {
+		var restoreReq *mongodb.RestoreClusterRequest
+		restoreReq, err = prepareRestoreMongodbRequest(d, req, backupID.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().MongoDB().Cluster().Restore(ctx, restoreReq))
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().MongoDB().Cluster().Create(ctx, req))
+	}
 	if err != nil {
 		return diag.Errorf("error while requesting API to create Mongodb Cluster: %s", err)
 	}
@@ -478,13 +539,15 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("error while get Mongodb create operation metadata: %s", err)
 	}
 
-	md, ok := protoMetadata.(*mongodb.CreateClusterMetadata)
-	if !ok {
+	switch md := protoMetadata.(type) {
+	case *mongodb.CreateClusterMetadata:
+		d.SetId(md.ClusterId)
+	case *mongodb.RestoreClusterMetadata:
+		d.SetId(md.ClusterId)
+	default:
 		return diag.Errorf("could not get Cluster ID from create operation metadata")
 	}
 
-	d.SetId(md.ClusterId)
-
 	err = op.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("error while waiting for operation to create Mongodb Cluster: %s", err)
@@ -505,6 +568,14 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	// Restore request does not accept deletion protection, so it is applied after the cluster is restored.
+	if restore && req.DeletionProtection {
+		err = updateMongoDBDeletionProtection(ctx, config, d, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceYandexMDBMongodbClusterRead(ctx, d, meta)
 }
 
@@ -526,6 +597,24 @@ func updateMongoDBMaintenanceWindow(ctx context.Context, config *Config, d *sche
 	return nil
 }
 
+func updateMongoDBDeletionProtection(ctx context.Context, config *Config, d *schema.ResourceData, deletionProtection bool) error {
+	op, err := config.sdk.WrapOperation(
+		config.sdk.MDB().MongoDB().Cluster().Update(ctx, &mongodb.UpdateClusterRequest{
+			ClusterId:          d.Id(),
+			DeletionProtection: deletionProtection,
+			UpdateMask:         &field_mask.FieldMask{Paths: []string{"deletion_protection"}},
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update deletion protection in MongoDB Cluster %q: %s", d.Id(), err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating deletion protection in MongoDB Cluster %q: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func listMongodbHosts(ctx context.Context, config *Config, d *schema.ResourceData) ([]*mongodb.Host, error) {
 	var hosts []*mongodb.Host
 	pageToken := ""
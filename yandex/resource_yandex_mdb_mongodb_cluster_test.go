@@ -16,9 +16,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 )
 
 const mongodbResource = "yandex_mdb_mongodb_cluster.foo"
@@ -1075,3 +1081,116 @@ func testAccCheckMDBMongoDBClusterHasDatabases(r string, databases []string) res
 		return nil
 	}
 }
+
+func TestPrepareRestoreMongodbRequest(t *testing.T) {
+	raw := map[string]interface{}{
+		"restore": []interface{}{
+			map[string]interface{}{
+				"backup_id": "backup1",
+				"time":      "2022-07-01T10:00:00",
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexMDBMongodbCluster().Schema, raw)
+
+	createReq := &mongodb.CreateClusterRequest{
+		FolderId:         "folder1",
+		Name:             "restored",
+		NetworkId:        "network1",
+		SecurityGroupIds: []string{"sg1"},
+	}
+
+	req, err := prepareRestoreMongodbRequest(resourceData, createReq, "backup1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if req.BackupId != "backup1" || req.Name != "restored" || req.FolderId != "folder1" || req.NetworkId != "network1" {
+		t.Errorf("unexpected restore request: %v", req)
+	}
+
+	expected := time.Date(2022, 7, 1, 10, 0, 0, 0, time.UTC).Unix()
+	if req.RecoveryTargetSpec.GetTimestamp() != expected {
+		t.Errorf("expected recovery target %d, got %d", expected, req.RecoveryTargetSpec.GetTimestamp())
+	}
+}
+
+func TestResourceYandexMDBMongodbClusterCreateRestoreError(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	l := localListener(t)
+
+	endpoint.RegisterApiEndpointServiceServer(grpcServer, &mockMongodbAPIEndpointServer{addr: l.Addr().String()})
+	mongodb.RegisterClusterServiceServer(grpcServer, &mockMongodbClusterServer{})
+
+	go func() { _ = grpcServer.Serve(l) }()
+	defer grpcServer.Stop()
+
+	config := &Config{
+		Endpoint:  l.Addr().String(),
+		FolderID:  testConfigFolder,
+		Token:     "t1.iam.token",
+		Insecure:  true,
+		Plaintext: true,
+	}
+	require.NoError(t, config.initAndValidate(context.Background(), testTerraformVersion, false))
+
+	raw := map[string]interface{}{
+		"name":        "restored",
+		"environment": "PRESTABLE",
+		"network_id":  "network1",
+		"cluster_config": []interface{}{
+			map[string]interface{}{
+				"version": "4.2",
+			},
+		},
+		"host": []interface{}{
+			map[string]interface{}{
+				"zone_id":   "ru-central1-a",
+				"subnet_id": "subnet1",
+			},
+		},
+		"resources": []interface{}{
+			map[string]interface{}{
+				"resource_preset_id": "s2.micro",
+				"disk_size":          16,
+				"disk_type_id":       "network-hdd",
+			},
+		},
+		"restore": []interface{}{
+			map[string]interface{}{
+				"backup_id": "unknown-backup",
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexMDBMongodbCluster().Schema, raw)
+
+	diags := resourceYandexMDBMongodbClusterCreate(context.Background(), resourceData, config)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "backup unknown-backup not found")
+	require.Empty(t, resourceData.Id())
+}
+
+// mockMongodbAPIEndpointServer points the SDK to the same server for the Managed Service for MongoDB
+type mockMongodbAPIEndpointServer struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	addr string
+}
+
+func (s *mockMongodbAPIEndpointServer) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	return &endpoint.ListApiEndpointsResponse{
+		Endpoints: []*endpoint.ApiEndpoint{
+			{
+				Id:      "managed-mongodb",
+				Address: s.addr,
+			},
+		},
+	}, nil
+}
+
+type mockMongodbClusterServer struct {
+	mongodb.UnimplementedClusterServiceServer
+}
+
+func (s *mockMongodbClusterServer) Restore(_ context.Context, r *mongodb.RestoreClusterRequest) (*operation.Operation, error) {
+	return nil, status.Errorf(codes.NotFound, "backup %s not found", r.BackupId)
+}
@@ -567,10 +567,21 @@ func resourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	// Hosts which are unknown to the resource are managed by yandex_mdb_postgresql_host resources.
+	// Right after update the new hosts are unknown too, so only unmatched ones are ignored in that case.
+	declaredHosts, pendingHosts := pgDeclaredHostFQDNs(d.Get("host").([]interface{}))
+	unmanagedHosts := pgUnmanagedHosts(hosts, declaredHosts)
+	if !pendingHosts {
+		hosts = excludePGHosts(hosts, unmanagedHosts)
+	}
+
 	orderedHostInfos, err := flattenPGHostsInfo(d, hosts)
 	if err != nil {
 		return err
 	}
+	if pendingHosts {
+		orderedHostInfos = excludeUnmatchedPGHostsInfo(orderedHostInfos, unmanagedHosts)
+	}
 
 	fHosts := flattenPGHostsFromHostInfos(orderedHostInfos, false)
 	masterHostname := getMasterHostname(orderedHostInfos)
@@ -673,7 +684,7 @@ func resourceYandexMDBPostgreSQLClusterCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("PostgreSQL Cluster creation failed: %s", err)
 	}
 
-	if err := createPGClusterHosts(ctx, config, d); err != nil {
+	if err := createPGClusterHosts(ctx, config, d, nil); err != nil {
 		return fmt.Errorf("PostgreSQL Cluster %v hosts creation failed: %s", d.Id(), err)
 	}
 
@@ -756,7 +767,7 @@ func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta inte
 		return fmt.Errorf("PostgreSQL Cluster creation from backup %v failed: %s", backupID, err)
 	}
 
-	if err := createPGClusterHosts(ctx, config, d); err != nil {
+	if err := createPGClusterHosts(ctx, config, d, nil); err != nil {
 		return fmt.Errorf("PostgreSQL Cluster %v hosts creation from backup %v failed: %s", d.Id(), backupID, err)
 	}
 
@@ -1186,17 +1197,27 @@ func updatePGClusterHosts(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
+	// Hosts managed by yandex_mdb_postgresql_host resources must not be touched
+	currHosts, err := listPGHosts(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+	oldHosts, _ := d.GetChange("host")
+	declaredHosts, _ := pgDeclaredHostFQDNs(oldHosts.([]interface{}))
+	unmanagedHosts := pgUnmanagedHosts(currHosts, declaredHosts)
+
 	// Step 1: Add new hosts (as HA-hosts):
-	err := createPGClusterHosts(ctx, config, d)
+	err = createPGClusterHosts(ctx, config, d, unmanagedHosts)
 	if err != nil {
 		return err
 	}
 
 	// Step 2: update hosts:
-	currHosts, err := listPGHosts(ctx, config, d.Id())
+	currHosts, err = listPGHosts(ctx, config, d.Id())
 	if err != nil {
 		return err
 	}
+	currHosts = excludePGHosts(currHosts, unmanagedHosts)
 
 	compareHostsInfo, err := comparePGHostsInfo(d, currHosts, true)
 	if err != nil {
@@ -1241,11 +1262,12 @@ func updatePGClusterHosts(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func createPGClusterHosts(ctx context.Context, config *Config, d *schema.ResourceData) error {
+func createPGClusterHosts(ctx context.Context, config *Config, d *schema.ResourceData, unmanagedHosts map[string]bool) error {
 	hosts, err := listPGHosts(ctx, config, d.Id())
 	if err != nil {
 		return err
 	}
+	hosts = excludePGHosts(hosts, unmanagedHosts)
 	compareHostsInfo, err := comparePGHostsInfo(d, hosts, true)
 	if err != nil {
 		return err
@@ -1270,7 +1292,7 @@ func createPGClusterHosts(ctx context.Context, config *Config, d *schema.Resourc
 		}
 	}
 	if compareHostsInfo.hierarchyExists {
-		return createPGClusterHosts(ctx, config, d)
+		return createPGClusterHosts(ctx, config, d, unmanagedHosts)
 	}
 
 	return nil
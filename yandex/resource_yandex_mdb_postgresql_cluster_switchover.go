@@ -0,0 +1,104 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+const (
+	yandexMDBPostgreSQLClusterSwitchoverCreateTimeout = 30 * time.Minute
+	yandexMDBPostgreSQLClusterSwitchoverReadTimeout   = 1 * time.Minute
+)
+
+// Switchover is an action rather than an object: it is performed on create and
+// repeated whenever any of the ForceNew arguments (e.g. triggers) changes.
+func resourceYandexMDBPostgreSQLClusterSwitchover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBPostgreSQLClusterSwitchoverCreate,
+		Read:   resourceYandexMDBPostgreSQLClusterSwitchoverRead,
+		Delete: resourceYandexMDBPostgreSQLClusterSwitchoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBPostgreSQLClusterSwitchoverCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBPostgreSQLClusterSwitchoverReadTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"master_host_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBPostgreSQLClusterSwitchoverCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	hostName := d.Get("host_name").(string)
+
+	d.SetId(clusterID)
+
+	log.Printf("[DEBUG] Starting switchover of PostgreSQL Cluster %q to host %q", clusterID, hostName)
+	if err := startPGFailover(ctx, config, d, hostName); err != nil {
+		d.SetId("")
+		return err
+	}
+	log.Printf("[DEBUG] Finished switchover of PostgreSQL Cluster %q", clusterID)
+
+	return resourceYandexMDBPostgreSQLClusterSwitchoverRead(d, meta)
+}
+
+func resourceYandexMDBPostgreSQLClusterSwitchoverRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	hosts, err := listPGHosts(ctx, config, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id()))
+	}
+
+	masterHostName := ""
+	for _, h := range hosts {
+		if h.Role == postgresql.Host_MASTER {
+			masterHostName = h.Name
+			break
+		}
+	}
+
+	d.Set("cluster_id", d.Id())
+	return d.Set("master_host_name", masterHostName)
+}
+
+func resourceYandexMDBPostgreSQLClusterSwitchoverDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing switchover of PostgreSQL Cluster %q from state", d.Id())
+	return nil
+}
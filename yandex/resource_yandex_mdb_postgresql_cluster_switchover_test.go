@@ -0,0 +1,51 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const pgSwitchoverResourceName = "yandex_mdb_postgresql_cluster_switchover.to_b"
+
+func TestAccMDBPostgreSQLClusterSwitchover_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-switchover")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLClusterSwitchoverConfig(clusterName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(pgSwitchoverResourceName, "master_host_name", pgHostResourceName, "fqdn"),
+				),
+			},
+			{
+				Config: testAccMDBPostgreSQLClusterSwitchoverConfig(clusterName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(pgSwitchoverResourceName, "triggers.run", "2"),
+					resource.TestCheckResourceAttrPair(pgSwitchoverResourceName, "master_host_name", pgHostResourceName, "fqdn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBPostgreSQLClusterSwitchoverConfig(name, run string) string {
+	return testAccMDBPostgreSQLHostConfig(name, 5) + fmt.Sprintf(`
+resource "yandex_mdb_postgresql_cluster_switchover" "to_b" {
+  cluster_id = yandex_mdb_postgresql_cluster.foo.id
+  host_name  = yandex_mdb_postgresql_host.b.fqdn
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, run)
+}
@@ -0,0 +1,253 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	yandexMDBPostgreSQLHostCreateTimeout = 60 * time.Minute
+	yandexMDBPostgreSQLHostReadTimeout   = 1 * time.Minute
+	yandexMDBPostgreSQLHostUpdateTimeout = 30 * time.Minute
+	yandexMDBPostgreSQLHostDeleteTimeout = 30 * time.Minute
+)
+
+func resourceYandexMDBPostgreSQLHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBPostgreSQLHostCreate,
+		Read:   resourceYandexMDBPostgreSQLHostRead,
+		Update: resourceYandexMDBPostgreSQLHostUpdate,
+		Delete: resourceYandexMDBPostgreSQLHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBPostgreSQLHostCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBPostgreSQLHostReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBPostgreSQLHostUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBPostgreSQLHostDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"assign_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"replication_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBPostgreSQLHostCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	spec := &postgresql.HostSpec{
+		ZoneId:            d.Get("zone").(string),
+		SubnetId:          d.Get("subnet_id").(string),
+		AssignPublicIp:    d.Get("assign_public_ip").(bool),
+		ReplicationSource: d.Get("replication_source").(string),
+	}
+	if v, ok := d.GetOk("priority"); ok {
+		spec.Priority = &wrappers.Int64Value{Value: int64(v.(int))}
+	}
+
+	request := &postgresql.AddClusterHostsRequest{
+		ClusterId: clusterID,
+		HostSpecs: []*postgresql.HostSpec{spec},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending PostgreSQL cluster add hosts request: %+v", request)
+		return config.sdk.MDB().PostgreSQL().Cluster().AddHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to add host to PostgreSQL Cluster %q: %s", clusterID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while get PostgreSQL host create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*postgresql.AddClusterHostsMetadata)
+	if !ok || len(md.HostNames) != 1 {
+		return fmt.Errorf("could not get host name from create operation metadata")
+	}
+
+	d.SetId(constructResourceId(clusterID, md.HostNames[0]))
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while adding host to PostgreSQL Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("host creation failed: %s", err)
+	}
+	log.Printf("[DEBUG] Finished creating PostgreSQL host %q", md.HostNames[0])
+
+	return resourceYandexMDBPostgreSQLHostRead(d, meta)
+}
+
+func resourceYandexMDBPostgreSQLHostRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, fqdn, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hosts, err := listPGHosts(ctx, config, clusterID)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID))
+	}
+
+	var host *postgresql.Host
+	for _, h := range hosts {
+		if h.Name == fqdn {
+			host = h
+			break
+		}
+	}
+	if host == nil {
+		log.Printf("[WARN] Removing PostgreSQL host %q because it's gone", fqdn)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("zone", host.ZoneId)
+	d.Set("subnet_id", host.SubnetId)
+	d.Set("assign_public_ip", host.AssignPublicIp)
+	d.Set("replication_source", host.ReplicationSource)
+	d.Set("priority", host.Priority.GetValue())
+	d.Set("role", host.Role.String())
+	return d.Set("fqdn", host.Name)
+}
+
+func resourceYandexMDBPostgreSQLHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	var maskPaths []string
+	for _, field := range []string{"assign_public_ip", "replication_source", "priority"} {
+		if d.HasChange(field) {
+			maskPaths = append(maskPaths, field)
+		}
+	}
+
+	if len(maskPaths) > 0 {
+		request := &postgresql.UpdateClusterHostsRequest{
+			ClusterId: clusterID,
+			UpdateHostSpecs: []*postgresql.UpdateHostSpec{
+				{
+					HostName:          fqdn,
+					AssignPublicIp:    d.Get("assign_public_ip").(bool),
+					ReplicationSource: d.Get("replication_source").(string),
+					Priority:          &wrappers.Int64Value{Value: int64(d.Get("priority").(int))},
+					UpdateMask:        &field_mask.FieldMask{Paths: maskPaths},
+				},
+			},
+		}
+		op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+			log.Printf("[DEBUG] Sending PostgreSQL cluster update hosts request: %+v", request)
+			return config.sdk.MDB().PostgreSQL().Cluster().UpdateHosts(ctx, request)
+		})
+		if err != nil {
+			return fmt.Errorf("error while requesting API to update host %q in PostgreSQL Cluster %q: %s", fqdn, clusterID, err)
+		}
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error while updating host %q in PostgreSQL Cluster %q: %s", fqdn, clusterID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating PostgreSQL host %q", fqdn)
+	return resourceYandexMDBPostgreSQLHostRead(d, meta)
+}
+
+func resourceYandexMDBPostgreSQLHostDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	log.Printf("[DEBUG] Deleting PostgreSQL host %q", fqdn)
+	request := &postgresql.DeleteClusterHostsRequest{
+		ClusterId: clusterID,
+		HostNames: []string{fqdn},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending PostgreSQL cluster delete hosts request: %+v", request)
+		return config.sdk.MDB().PostgreSQL().Cluster().DeleteHosts(ctx, request)
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PostgreSQL host %q", fqdn))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while deleting host %q from PostgreSQL Cluster %q: %s", fqdn, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting PostgreSQL host %q", fqdn)
+	return nil
+}
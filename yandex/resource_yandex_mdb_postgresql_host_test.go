@@ -0,0 +1,85 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+const pgHostResourceName = "yandex_mdb_postgresql_host.b"
+
+// Test that a PostgreSQL host can be managed outside of the PostgreSQL Cluster resource
+func TestAccMDBPostgreSQLHost_basic(t *testing.T) {
+	t.Parallel()
+
+	var cluster postgresql.Cluster
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-host")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLHostConfig(clusterName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPGClusterExists(pgClusterResourceName, &cluster, 2),
+					resource.TestCheckResourceAttr(pgClusterResourceName, "host.#", "1"),
+					resource.TestCheckResourceAttr(pgHostResourceName, "zone", "ru-central1-b"),
+					resource.TestCheckResourceAttr(pgHostResourceName, "priority", "5"),
+					resource.TestCheckResourceAttr(pgHostResourceName, "role", "REPLICA"),
+					resource.TestCheckResourceAttrSet(pgHostResourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      pgHostResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMDBPostgreSQLHostConfig(clusterName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPGClusterExists(pgClusterResourceName, &cluster, 2),
+					resource.TestCheckResourceAttr(pgClusterResourceName, "host.#", "1"),
+					resource.TestCheckResourceAttr(pgHostResourceName, "priority", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBPostgreSQLHostConfig(name string, priority int) string {
+	return fmt.Sprintf(pgVPCDependencies+`
+resource "yandex_mdb_postgresql_cluster" "foo" {
+  name        = "%s"
+  description = "PostgreSQL Host Terraform Test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-pg-test-net.id
+
+  config {
+    version = 14
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 10
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  host {
+    name      = "a"
+    zone      = "ru-central1-a"
+    subnet_id = yandex_vpc_subnet.mdb-pg-test-subnet-a.id
+  }
+}
+
+resource "yandex_mdb_postgresql_host" "b" {
+  cluster_id = yandex_mdb_postgresql_cluster.foo.id
+  zone       = "ru-central1-b"
+  subnet_id  = yandex_vpc_subnet.mdb-pg-test-subnet-b.id
+  priority   = %d
+}
+`, name, priority)
+}
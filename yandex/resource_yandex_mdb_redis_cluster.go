@@ -15,6 +15,7 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 )
 
 const (
@@ -137,6 +138,43 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 					},
 				},
 			},
+			"backup_window_start": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+			"access": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_lens": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"host": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -255,6 +293,21 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restore": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -271,7 +324,14 @@ func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Create(ctx, req))
+	backupID, restore := d.GetOk("restore.0.backup_id")
+
+	var op *sdkoperation.Operation
+	if restore {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Restore(ctx, prepareRestoreRedisRequest(req, backupID.(string))))
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Create(ctx, req))
+	}
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to create Redis Cluster: %s", err)
 	}
@@ -281,13 +341,15 @@ func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error while get redis create operation metadata: %s", err)
 	}
 
-	md, ok := protoMetadata.(*redis.CreateClusterMetadata)
-	if !ok {
+	switch md := protoMetadata.(type) {
+	case *redis.CreateClusterMetadata:
+		d.SetId(md.ClusterId)
+	case *redis.RestoreClusterMetadata:
+		d.SetId(md.ClusterId)
+	default:
 		return fmt.Errorf("Could not get Cluster ID from create operation metadata")
 	}
-
-	d.SetId(md.ClusterId)
-	log.Printf("[DEBUG] Creating Redis Cluster %q", md.ClusterId)
+	log.Printf("[DEBUG] Creating Redis Cluster %q", d.Id())
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -309,6 +371,14 @@ func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	// Restore request does not accept deletion protection, so it is applied after the cluster is restored.
+	if restore && req.DeletionProtection {
+		err = updateRedisDeletionProtection(ctx, config, d, true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexMDBRedisClusterRead(d, meta)
 }
 
@@ -347,9 +417,11 @@ func prepareCreateRedisRequest(d *schema.ResourceData, meta *Config) (*redis.Cre
 	}
 
 	configSpec := &redis.ConfigSpec{
-		RedisSpec: *conf,
-		Resources: resources,
-		Version:   version,
+		RedisSpec:         *conf,
+		Resources:         resources,
+		Version:           version,
+		BackupWindowStart: expandRedisBackupWindowStart(d),
+		Access:            expandRedisAccess(d),
 	}
 
 	securityGroupIds := expandSecurityGroupIds(d.Get("security_group_ids"))
@@ -382,6 +454,23 @@ func prepareCreateRedisRequest(d *schema.ResourceData, meta *Config) (*redis.Cre
 	return &req, nil
 }
 
+func prepareRestoreRedisRequest(req *redis.CreateClusterRequest, backupID string) *redis.RestoreClusterRequest {
+	return &redis.RestoreClusterRequest{
+		BackupId:         backupID,
+		Name:             req.Name,
+		Description:      req.Description,
+		Labels:           req.Labels,
+		Environment:      req.Environment,
+		ConfigSpec:       req.ConfigSpec,
+		HostSpecs:        req.HostSpecs,
+		NetworkId:        req.NetworkId,
+		FolderId:         req.FolderId,
+		SecurityGroupIds: req.SecurityGroupIds,
+		TlsEnabled:       req.TlsEnabled,
+		PersistenceMode:  req.PersistenceMode,
+	}
+}
+
 func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -445,6 +534,14 @@ func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	if err := d.Set("backup_window_start", flattenRedisBackupWindowStart(cluster.Config.BackupWindowStart)); err != nil {
+		return err
+	}
+
+	if err := d.Set("access", flattenRedisAccess(cluster.Config.Access)); err != nil {
+		return err
+	}
+
 	// Do not change the state if only order of hosts differs.
 	dHosts, err := expandRedisHosts(d)
 	if err != nil {
@@ -568,6 +665,32 @@ func updateRedisClusterParams(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
+	if d.HasChange("backup_window_start") {
+		if req.ConfigSpec == nil {
+			req.ConfigSpec = &redis.ConfigSpec{}
+		}
+
+		req.ConfigSpec.BackupWindowStart = expandRedisBackupWindowStart(d)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "config_spec.backup_window_start")
+
+		onDone = append(onDone, func() {
+
+		})
+	}
+
+	if d.HasChange("access") {
+		if req.ConfigSpec == nil {
+			req.ConfigSpec = &redis.ConfigSpec{}
+		}
+
+		req.ConfigSpec.Access = expandRedisAccess(d)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "config_spec.access")
+
+		onDone = append(onDone, func() {
+
+		})
+	}
+
 	if d.HasChange("config") {
 		if d.HasChange("config.0.version") {
 			return fmt.Errorf("Version update for Redis is not supported")
@@ -783,6 +906,24 @@ func updateRedisMaintenanceWindow(ctx context.Context, config *Config, d *schema
 	return nil
 }
 
+func updateRedisDeletionProtection(ctx context.Context, config *Config, d *schema.ResourceData, deletionProtection bool) error {
+	op, err := config.sdk.WrapOperation(
+		config.sdk.MDB().Redis().Cluster().Update(ctx, &redis.UpdateClusterRequest{
+			ClusterId:          d.Id(),
+			DeletionProtection: deletionProtection,
+			UpdateMask:         &field_mask.FieldMask{Paths: []string{"deletion_protection"}},
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update deletion protection in Redis Cluster %q: %s", d.Id(), err)
+	}
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while updating deletion protection in Redis Cluster %q: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func listRedisHosts(ctx context.Context, config *Config, d *schema.ResourceData) ([]*redis.Host, error) {
 	hosts := []*redis.Host{}
 	pageToken := ""
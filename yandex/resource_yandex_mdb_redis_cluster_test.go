@@ -208,6 +208,9 @@ func TestAccMDBRedisCluster_full_networkssd(t *testing.T) {
 					testAccCheckCreatedAtAttr(redisResource),
 					resource.TestCheckResourceAttr(redisResource, "security_group_ids.#", "2"),
 					resource.TestCheckResourceAttr(redisResource, "maintenance_window.0.type", "ANYTIME"),
+					resource.TestCheckResourceAttr(redisResource, "backup_window_start.0.hours", "5"),
+					resource.TestCheckResourceAttr(redisResource, "backup_window_start.0.minutes", "30"),
+					resource.TestCheckResourceAttr(redisResource, "access.0.data_lens", "true"),
 				),
 			},
 			mdbRedisClusterImportStep(redisResource),
@@ -327,6 +330,9 @@ func TestAccMDBRedisCluster_full_localssd(t *testing.T) {
 					testAccCheckMDBRedisClusterContainsLabel(&r, "new_key", "new_value"),
 					testAccCheckCreatedAtAttr(redisResource),
 					resource.TestCheckResourceAttr(redisResource, "maintenance_window.0.type", "ANYTIME"),
+					resource.TestCheckResourceAttr(redisResource, "backup_window_start.0.hours", "5"),
+					resource.TestCheckResourceAttr(redisResource, "backup_window_start.0.minutes", "30"),
+					resource.TestCheckResourceAttr(redisResource, "access.0.data_lens", "true"),
 				),
 			},
 			mdbRedisClusterImportStep(redisResource),
@@ -873,6 +879,15 @@ resource "yandex_mdb_redis_cluster" "foo" {
 
   security_group_ids = ["${yandex_vpc_security_group.sg-x.id}", "${yandex_vpc_security_group.sg-y.id}"]
 
+  backup_window_start {
+    hours   = 5
+    minutes = 30
+  }
+
+  access {
+    data_lens = true
+  }
+
   maintenance_window {
     type = "ANYTIME"
   }
@@ -0,0 +1,244 @@
+package yandex
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func resourceYandexOrganizationManagerSamlFederationCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexOrganizationManagerSamlFederationCertificateCreate,
+		Read:   resourceYandexOrganizationManagerSamlFederationCertificateRead,
+		Update: resourceYandexOrganizationManagerSamlFederationCertificateUpdate,
+		Delete: resourceYandexOrganizationManagerSamlFederationCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateParsableValue(parseSamlFederationCertificate),
+				DiffSuppressFunc: shouldSuppressDiffForSamlFederationCertificateData,
+			},
+
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseSamlFederationCertificate parses the X.509 certificate in PEM format used to verify signatures of the IdP.
+func parseSamlFederationCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("certificate data should be a PEM encoded X.509 certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %s", err)
+	}
+	return cert, nil
+}
+
+func shouldSuppressDiffForSamlFederationCertificateData(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	req := saml.CreateCertificateRequest{
+		FederationId: d.Get("federation_id").(string),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Data:         d.Get("data").(string),
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Certificate().Create(ctx, &req))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to create SAML Federation Certificate: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("Error while get SAML Federation Certificate create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*saml.CreateCertificateMetadata)
+	if !ok {
+		return fmt.Errorf("could not get SAML Federation Certificate ID from create operation metadata")
+	}
+
+	d.SetId(md.CertificateId)
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to create SAML Federation Certificate: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("SAML Federation Certificate creation failed: %s", err)
+	}
+
+	return resourceYandexOrganizationManagerSamlFederationCertificateRead(d, meta)
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	certificate, err := config.sdk.OrganizationManagerSAML().Certificate().Get(ctx, &saml.GetCertificateRequest{
+		CertificateId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SAML Federation Certificate %q", d.Id()))
+	}
+
+	d.Set("federation_id", certificate.FederationId)
+	d.Set("name", certificate.Name)
+	d.Set("description", certificate.Description)
+	d.Set("data", certificate.Data)
+	d.Set("created_at", getTimestamp(certificate.CreatedAt))
+
+	cert, err := parseSamlFederationCertificate(certificate.Data)
+	if err != nil {
+		log.Printf("[WARN] Failed to parse data of SAML Federation Certificate %q: %s", d.Id(), err)
+		d.Set("fingerprint", "")
+		d.Set("not_before", "")
+		d.Set("not_after", "")
+		return nil
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+	d.Set("fingerprint", hex.EncodeToString(fingerprint[:]))
+	d.Set("not_before", cert.NotBefore.Format(defaultTimeFormat))
+	d.Set("not_after", cert.NotAfter.Format(defaultTimeFormat))
+
+	return nil
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	req := &saml.UpdateCertificateRequest{
+		CertificateId: d.Id(),
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Data:          d.Get("data").(string),
+		UpdateMask:    &field_mask.FieldMask{},
+	}
+
+	for _, field := range []string{"name", "description", "data"} {
+		if d.HasChange(field) {
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, field)
+		}
+	}
+
+	if len(req.UpdateMask.Paths) == 0 {
+		return fmt.Errorf("No fields were updated for SAML Federation Certificate %s", d.Id())
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Certificate().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update SAML Federation Certificate %q: %s", d.Id(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating SAML Federation Certificate %q: %s", d.Id(), err)
+	}
+
+	return resourceYandexOrganizationManagerSamlFederationCertificateRead(d, meta)
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting SAML Federation Certificate %q", d.Id())
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Certificate().Delete(ctx, &saml.DeleteCertificateRequest{
+		CertificateId: d.Id(),
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SAML Federation Certificate %q", d.Id()))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = op.Response()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting SAML Federation Certificate %q", d.Id())
+	return nil
+}
@@ -0,0 +1,120 @@
+package yandex
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccOrganizationManagerSamlFederationCertificate_basic(t *testing.T) {
+	t.Parallel()
+
+	info := newSamlFederationInfo()
+	notAfter := time.Now().Add(365 * 24 * time.Hour).UTC().Truncate(time.Second)
+	data := testSamlFederationCertificatePEM(t, notAfter)
+	certName := "cert-" + acctest.RandString(10)
+	resourceName := "yandex_organizationmanager_saml_federation_certificate.cert"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSamlFederationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationManagerSamlFederationCertificate(info, certName, "first", data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "federation_id", info.getResourceName(true), "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", certName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "fingerprint", testSamlFederationCertificateFingerprint(t, data)),
+					resource.TestCheckResourceAttr(resourceName, "not_after", notAfter.Format(defaultTimeFormat)),
+					testAccCheckCreatedAtAttr(resourceName),
+				),
+			},
+			{
+				Config: testAccOrganizationManagerSamlFederationCertificate(info, certName, "second", data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationManagerSamlFederationCertificate(info *resourceSamlFederationInfo, name, description, data string) string {
+	return templateConfig(samlFederationConfigTemplate, info.Map()) + fmt.Sprintf(`
+resource "yandex_organizationmanager_saml_federation_certificate" "cert" {
+  federation_id = yandex_organizationmanager_saml_federation.%s.id
+  name          = "%s"
+  description   = "%s"
+  data          = <<EOT
+%sEOT
+}
+`, info.ResourceName, name, description, data)
+}
+
+func testSamlFederationCertificatePEM(t *testing.T, notAfter time.Time) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.org"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testSamlFederationCertificateFingerprint(t *testing.T, data string) string {
+	block, _ := pem.Decode([]byte(data))
+	require.NotNil(t, block)
+
+	fingerprint := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(fingerprint[:])
+}
+
+func TestParseSamlFederationCertificate(t *testing.T) {
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := testSamlFederationCertificatePEM(t, notAfter)
+
+	cert, err := parseSamlFederationCertificate(data)
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.org", cert.Subject.CommonName)
+	assert.Equal(t, notAfter, cert.NotAfter)
+
+	_, err = parseSamlFederationCertificate("not a certificate")
+	assert.Error(t, err)
+
+	_, err = parseSamlFederationCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})))
+	assert.Error(t, err)
+
+	_, err = parseSamlFederationCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("key")})))
+	assert.Error(t, err)
+
+	assert.True(t, shouldSuppressDiffForSamlFederationCertificateData("data", data, "\n"+data+"\n", nil))
+	assert.False(t, shouldSuppressDiffForSamlFederationCertificateData("data", data, testSamlFederationCertificatePEM(t, notAfter), nil))
+}
@@ -0,0 +1,184 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+)
+
+func resourceYandexOrganizationManagerSamlFederationUserAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexOrganizationManagerSamlFederationUserAccountCreate,
+		Read:   resourceYandexOrganizationManagerSamlFederationUserAccountRead,
+		Delete: resourceYandexOrganizationManagerSamlFederationUserAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceYandexOrganizationManagerSamlFederationUserAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	federationID := d.Get("federation_id").(string)
+	nameID := d.Get("name_id").(string)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	// AddUserAccounts returns an already existing account for the name ID, which must not be adopted:
+	// deleting the resource would remove the user from the organization.
+	existing, err := findSamlFederationUserAccount(ctx, config, federationID, nameID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("user account %q with name_id %q already exists in SAML Federation %q, "+
+			"import it to manage it with Terraform", existing.Id, nameID, federationID)
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Federation().AddUserAccounts(ctx, &saml.AddFederatedUserAccountsRequest{
+		FederationId: federationID,
+		NameIds:      []string{nameID},
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to add user account %q to SAML Federation %q: %s", nameID, federationID, err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to add user account %q to SAML Federation %q: %s", nameID, federationID, err)
+	}
+
+	protoResponse, err := op.Response()
+	if err != nil {
+		return fmt.Errorf("Adding user account %q to SAML Federation %q failed: %s", nameID, federationID, err)
+	}
+
+	resp, ok := protoResponse.(*saml.AddFederatedUserAccountsResponse)
+	if !ok {
+		return fmt.Errorf("could not get user account ID from add user accounts operation response")
+	}
+
+	if len(resp.UserAccounts) != 1 {
+		return fmt.Errorf("expected one user account with name_id %q in SAML Federation %q, got %d", nameID, federationID, len(resp.UserAccounts))
+	}
+
+	d.SetId(resp.UserAccounts[0].Id)
+
+	return resourceYandexOrganizationManagerSamlFederationUserAccountRead(d, meta)
+}
+
+func findSamlFederationUserAccount(ctx context.Context, config *Config, federationID, nameID string) (*organizationmanager.UserAccount, error) {
+	pageToken := ""
+	for {
+		resp, err := config.sdk.OrganizationManagerSAML().Federation().ListUserAccounts(ctx, &saml.ListFederatedUserAccountsRequest{
+			FederationId: federationID,
+			PageSize:     defaultListSize,
+			PageToken:    pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error while listing user accounts of SAML Federation %q: %s", federationID, err)
+		}
+		for _, account := range resp.UserAccounts {
+			if account.GetSamlUserAccount().GetNameId() == nameID {
+				return account, nil
+			}
+		}
+		if resp.NextPageToken == "" {
+			return nil, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func resourceYandexOrganizationManagerSamlFederationUserAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	account, err := config.sdk.IAM().UserAccount().Get(ctx, &iam.GetUserAccountRequest{
+		UserAccountId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SAML Federation user account %q", d.Id()))
+	}
+
+	samlAccount := account.GetSamlUserAccount()
+	if samlAccount == nil {
+		return fmt.Errorf("user account %q is not a SAML federated user account", d.Id())
+	}
+
+	d.Set("federation_id", samlAccount.FederationId)
+	d.Set("name_id", samlAccount.NameId)
+
+	return nil
+}
+
+func resourceYandexOrganizationManagerSamlFederationUserAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	federation, err := config.sdk.OrganizationManagerSAML().Federation().Get(ctx, &saml.GetFederationRequest{
+		FederationId: d.Get("federation_id").(string),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SAML Federation %q", d.Get("federation_id").(string)))
+	}
+
+	log.Printf("[DEBUG] Deleting SAML Federation user account %q", d.Id())
+
+	// federated user accounts are deleted along with their membership in the organization of the federation
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManager().User().DeleteMembership(ctx, &organizationmanager.DeleteMembershipRequest{
+		OrganizationId: federation.OrganizationId,
+		SubjectId:      d.Id(),
+	}))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SAML Federation user account %q", d.Id()))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = op.Response()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting SAML Federation user account %q", d.Id())
+	return nil
+}
@@ -0,0 +1,95 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+func TestAccOrganizationManagerSamlFederationUserAccount_basic(t *testing.T) {
+	t.Parallel()
+
+	info := newSamlFederationInfo()
+	nameID := fmt.Sprintf("%s@example.org", acctest.RandString(10))
+	resourceName := "yandex_organizationmanager_saml_federation_user_account.account"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSamlFederationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationManagerSamlFederationUserAccount(info, nameID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "federation_id", info.getResourceName(true), "id"),
+					resource.TestCheckResourceAttr(resourceName, "name_id", nameID),
+					testAccCheckSamlFederationUserAccountExists(resourceName, nameID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// existing user accounts are not adopted
+			{
+				Config:      testAccOrganizationManagerSamlFederationUserAccount(info, nameID) + testAccOrganizationManagerSamlFederationUserAccountDuplicate(info, nameID),
+				ExpectError: regexp.MustCompile("already exists in SAML Federation"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationManagerSamlFederationUserAccount(info *resourceSamlFederationInfo, nameID string) string {
+	return templateConfig(samlFederationConfigTemplate, info.Map()) + fmt.Sprintf(`
+resource "yandex_organizationmanager_saml_federation_user_account" "account" {
+  federation_id = yandex_organizationmanager_saml_federation.%s.id
+  name_id       = "%s"
+}
+
+resource "yandex_organizationmanager_organization_iam_member" "viewer" {
+  organization_id = yandex_organizationmanager_saml_federation.%s.organization_id
+  role            = "viewer"
+  member          = "federatedUser:${yandex_organizationmanager_saml_federation_user_account.account.id}"
+}
+`, info.ResourceName, nameID, info.ResourceName)
+}
+
+func testAccOrganizationManagerSamlFederationUserAccountDuplicate(info *resourceSamlFederationInfo, nameID string) string {
+	return fmt.Sprintf(`
+resource "yandex_organizationmanager_saml_federation_user_account" "duplicate" {
+  federation_id = yandex_organizationmanager_saml_federation.%s.id
+  name_id       = "%s"
+
+  depends_on = [yandex_organizationmanager_saml_federation_user_account.account]
+}
+`, info.ResourceName, nameID)
+}
+
+func testAccCheckSamlFederationUserAccountExists(resourceName, nameID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		account, err := config.sdk.IAM().UserAccount().Get(context.Background(), &iam.GetUserAccountRequest{
+			UserAccountId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if account.GetSamlUserAccount().GetNameId() != nameID {
+			return fmt.Errorf("user account %q has name_id %q, expected %q", rs.Primary.ID, account.GetSamlUserAccount().GetNameId(), nameID)
+		}
+		return nil
+	}
+}
@@ -22,7 +22,7 @@ func resourceYandexResourceManagerCloud() *schema.Resource {
 		Update: resourceYandexResourceManagerCloudUpdate,
 		Delete: resourceYandexResourceManagerCloudDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexResourceManagerCloudImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -59,6 +59,19 @@ func resourceYandexResourceManagerCloud() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deletion_grace_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0s",
+				ValidateFunc: validateParsableValue(time.ParseDuration),
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -165,8 +178,9 @@ func resourceYandexResourceManagerCloudUpdate(d *schema.ResourceData, meta inter
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
+	// deletion settings are used by the provider only
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Cloud %s", d.Id())
+		return resourceYandexResourceManagerCloudRead(d, meta)
 	}
 
 	err := makeCloudUpdateRequest(req, d, meta)
@@ -180,11 +194,20 @@ func resourceYandexResourceManagerCloudUpdate(d *schema.ResourceData, meta inter
 func resourceYandexResourceManagerCloudDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cloud %q has deletion protection enabled, set `deletion_protection = false` and apply before destroying it", d.Id())
+	}
+
 	log.Printf("[DEBUG] Deleting Cloud %q", d.Id())
 
+	gracePeriod, err := time.ParseDuration(d.Get("deletion_grace_period").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing deletion_grace_period of Cloud %q: %s", d.Id(), err)
+	}
+
 	req := &resourcemanager.DeleteCloudRequest{
 		CloudId:     d.Id(),
-		DeleteAfter: timestamppb.Now(),
+		DeleteAfter: timestamppb.New(time.Now().Add(gracePeriod)),
 	}
 
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
@@ -205,10 +228,32 @@ func resourceYandexResourceManagerCloudDelete(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	if gracePeriod > 0 {
+		log.Printf("[DEBUG] Cloud %q is pending deletion until %s", d.Id(), req.DeleteAfter.AsTime())
+		return nil
+	}
+
+	err = waitResourceManagerResourceDeleted(ctx, d.Timeout(schema.TimeoutDelete), fmt.Sprintf("Cloud %q", d.Id()), func(ctx context.Context) error {
+		_, err := config.sdk.ResourceManager().Cloud().Get(ctx, &resourcemanager.GetCloudRequest{
+			CloudId: d.Id(),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Finished deleting Cloud %q", d.Id())
 	return nil
 }
 
+func resourceYandexResourceManagerCloudImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// deletion settings are used by the provider only, so they can not be read from API
+	d.Set("deletion_protection", false)
+	d.Set("deletion_grace_period", "0s")
+	return []*schema.ResourceData{d}, nil
+}
+
 func makeCloudUpdateRequest(req *resourcemanager.UpdateCloudRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
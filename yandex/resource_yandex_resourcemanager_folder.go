@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,7 +26,7 @@ func resourceYandexResourceManagerFolder() *schema.Resource {
 		Update: resourceYandexResourceManagerFolderUpdate,
 		Delete: resourceYandexResourceManagerFolderDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexResourceManagerFolderImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -59,10 +63,28 @@ func resourceYandexResourceManagerFolder() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deletion_grace_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0s",
+				ValidateFunc: validateParsableValue(time.ParseDuration),
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -131,10 +153,22 @@ func resourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta interf
 		return handleNotFoundError(err, d, fmt.Sprintf("Folder %q", d.Id()))
 	}
 
+	if folder.Status == resourcemanager.Folder_PENDING_DELETION {
+		log.Printf("[WARN] Removing Folder %q because it is pending deletion. To cancel the deletion, "+
+			"import the folder with %q as the import ID", d.Id(), d.Id()+folderRestoreImportSuffix)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("created_at", getTimestamp(folder.CreatedAt))
 	d.Set("name", folder.Name)
 	d.Set("cloud_id", folder.CloudId)
 	d.Set("description", folder.Description)
+	d.Set("status", strings.ToLower(folder.Status.String()))
+
+	if folder.Status != resourcemanager.Folder_ACTIVE {
+		log.Printf("[WARN] Folder %q is in %s status", d.Id(), folder.Status)
+	}
 
 	return d.Set("labels", folder.Labels)
 }
@@ -165,8 +199,9 @@ func resourceYandexResourceManagerFolderUpdate(d *schema.ResourceData, meta inte
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
+	// deletion settings are used by the provider only
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Folder %s", d.Id())
+		return resourceYandexResourceManagerFolderRead(d, meta)
 	}
 
 	err := makeFolderUpdateRequest(req, d, meta)
@@ -180,11 +215,20 @@ func resourceYandexResourceManagerFolderUpdate(d *schema.ResourceData, meta inte
 func resourceYandexResourceManagerFolderDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Folder %q has deletion protection enabled, set `deletion_protection = false` and apply before destroying it", d.Id())
+	}
+
 	log.Printf("[DEBUG] Deleting Folder %q", d.Id())
 
+	gracePeriod, err := time.ParseDuration(d.Get("deletion_grace_period").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing deletion_grace_period of Folder %q: %s", d.Id(), err)
+	}
+
 	req := &resourcemanager.DeleteFolderRequest{
 		FolderId:    d.Id(),
-		DeleteAfter: timestamppb.Now(),
+		DeleteAfter: timestamppb.New(time.Now().Add(gracePeriod)),
 	}
 
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
@@ -205,10 +249,140 @@ func resourceYandexResourceManagerFolderDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if gracePeriod > 0 {
+		log.Printf("[DEBUG] Folder %q is pending deletion until %s", d.Id(), req.DeleteAfter.AsTime())
+		return nil
+	}
+
+	// the operation finishes when the deletion is started, so the folder name can't be reused until it is gone
+	err = waitResourceManagerResourceDeleted(ctx, d.Timeout(schema.TimeoutDelete), fmt.Sprintf("Folder %q", d.Id()), func(ctx context.Context) error {
+		_, err := config.sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{
+			FolderId: d.Id(),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Finished deleting Folder %q", d.Id())
 	return nil
 }
 
+// Import ID of a folder pending deletion can have this suffix to cancel the deletion and restore the folder
+const folderRestoreImportSuffix = ":restore"
+
+func resourceYandexResourceManagerFolderImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	folderID := strings.TrimSuffix(d.Id(), folderRestoreImportSuffix)
+	restore := folderID != d.Id()
+	d.SetId(folderID)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	folder, err := config.sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{
+		FolderId: folderID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting Folder %q: %s", folderID, err)
+	}
+
+	if folder.Status == resourcemanager.Folder_PENDING_DELETION {
+		if !restore {
+			return nil, fmt.Errorf("Folder %q is pending deletion. To cancel the deletion and import the folder, "+
+				"use %q as the import ID", folderID, folderID+folderRestoreImportSuffix)
+		}
+
+		if err := restoreResourceManagerFolder(ctx, config, folderID); err != nil {
+			return nil, err
+		}
+	}
+
+	// deletion settings are used by the provider only, so they can not be read from API
+	d.Set("deletion_protection", false)
+	d.Set("deletion_grace_period", "0s")
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// restoreResourceManagerFolder cancels the pending delete operation of the folder and waits until it is active
+func restoreResourceManagerFolder(ctx context.Context, config *Config, folderID string) error {
+	log.Printf("[DEBUG] Restoring Folder %q", folderID)
+
+	resp, err := config.sdk.ResourceManager().Folder().ListOperations(ctx, &resourcemanager.ListFolderOperationsRequest{
+		FolderId: folderID,
+		PageSize: defaultListSize,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing operations of Folder %q: %s", folderID, err)
+	}
+
+	var deleteOperationID string
+	for _, op := range resp.Operations {
+		if !op.Done && op.Metadata.MessageIs(&resourcemanager.DeleteFolderMetadata{}) {
+			deleteOperationID = op.Id
+			break
+		}
+	}
+	if deleteOperationID == "" {
+		return fmt.Errorf("Error restoring Folder %q: pending delete operation is not found", folderID)
+	}
+
+	_, err = config.sdk.Operation().Cancel(ctx, &operation.CancelOperationRequest{
+		OperationId: deleteOperationID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error canceling delete operation %q of Folder %q: %s", deleteOperationID, folderID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcemanager.Folder_PENDING_DELETION.String()},
+		Target:  []string{resourcemanager.Folder_ACTIVE.String()},
+		Refresh: func() (interface{}, string, error) {
+			folder, err := config.sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{
+				FolderId: folderID,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return folder, folder.Status.String(), nil
+		},
+		Timeout:    yandexResourceManagerFolderDefaultTimeout,
+		MinTimeout: time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for Folder %q to be restored: %s", folderID, err)
+	}
+
+	return nil
+}
+
+// waitResourceManagerResourceDeleted waits until get returns NotFound for the deleted cloud or folder
+func waitResourceManagerResourceDeleted(ctx context.Context, timeout time.Duration, resourceName string, get func(ctx context.Context) error) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"exists"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			err := get(ctx)
+			if isStatusWithCode(err, codes.NotFound) {
+				return struct{}{}, "deleted", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			return struct{}{}, "exists", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for %s to be deleted: %s", resourceName, err)
+	}
+	return nil
+}
+
 func makeFolderUpdateRequest(req *resourcemanager.UpdateFolderRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -3,14 +3,22 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -86,12 +94,125 @@ func TestAccResourceManagerFolder_create(t *testing.T) {
 					resource.TestCheckResourceAttr("yandex_resourcemanager_folder.foobar", "name", folderInfo.Name),
 					resource.TestCheckResourceAttr("yandex_resourcemanager_folder.foobar", "description", folderInfo.Description),
 					resource.TestCheckResourceAttr("yandex_resourcemanager_folder.foobar", fmt.Sprintf("labels.%s", folderInfo.LabelKey), folderInfo.LabelValue),
+					resource.TestCheckResourceAttr("yandex_resourcemanager_folder.foobar", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "yandex_resourcemanager_folder.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceManagerFolder_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	folderInfo := newFolderInfo()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceManagerFolderDeletionProtection(folderInfo, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_resourcemanager_folder.foobar", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccResourceManagerFolderDeletionProtection(folderInfo, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("has deletion protection enabled"),
+			},
+			{
+				Config: testAccResourceManagerFolderDeletionProtection(folderInfo, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_resourcemanager_folder.foobar", "deletion_protection", "false"),
 				),
 			},
 		},
 	})
 }
 
+func TestWaitResourceManagerResourceDeleted(t *testing.T) {
+	err := waitResourceManagerResourceDeleted(context.Background(), time.Minute, "Folder \"id\"", func(context.Context) error {
+		return status.Error(codes.NotFound, "folder not found")
+	})
+	assert.NoError(t, err)
+
+	err = waitResourceManagerResourceDeleted(context.Background(), time.Minute, "Folder \"id\"", func(context.Context) error {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	})
+	assert.Error(t, err)
+}
+
+func TestResourceManagerFolderReadPendingDeletion(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	l := localListener(t)
+
+	endpoint.RegisterApiEndpointServiceServer(grpcServer, &mockResourceManagerAPIEndpointServer{addr: l.Addr().String()})
+	folderServer := &mockResourceManagerFolderServer{status: resourcemanager.Folder_ACTIVE}
+	resourcemanager.RegisterFolderServiceServer(grpcServer, folderServer)
+
+	go func() { _ = grpcServer.Serve(l) }()
+	defer grpcServer.Stop()
+
+	config := &Config{
+		Endpoint:  l.Addr().String(),
+		FolderID:  testConfigFolder,
+		Token:     "t1.iam.token",
+		Insecure:  true,
+		Plaintext: true,
+	}
+	require.NoError(t, config.initAndValidate(context.Background(), testTerraformVersion, false))
+
+	d := resourceYandexResourceManagerFolder().TestResourceData()
+	d.SetId("folder1")
+
+	require.NoError(t, resourceYandexResourceManagerFolderRead(d, config))
+	assert.Equal(t, "folder1", d.Id())
+	assert.Equal(t, "active", d.Get("status"))
+
+	// folder scheduled for deletion outside of Terraform is removed from state
+	folderServer.status = resourcemanager.Folder_PENDING_DELETION
+	require.NoError(t, resourceYandexResourceManagerFolderRead(d, config))
+	assert.Equal(t, "", d.Id())
+}
+
+// mockResourceManagerAPIEndpointServer points the SDK to the same server for the Resource Manager
+type mockResourceManagerAPIEndpointServer struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	addr string
+}
+
+func (s *mockResourceManagerAPIEndpointServer) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	return &endpoint.ListApiEndpointsResponse{
+		Endpoints: []*endpoint.ApiEndpoint{
+			{
+				Id:      "resource-manager",
+				Address: s.addr,
+			},
+		},
+	}, nil
+}
+
+type mockResourceManagerFolderServer struct {
+	resourcemanager.UnimplementedFolderServiceServer
+	status resourcemanager.Folder_Status
+}
+
+func (s *mockResourceManagerFolderServer) Get(_ context.Context, r *resourcemanager.GetFolderRequest) (*resourcemanager.Folder, error) {
+	return &resourcemanager.Folder{
+		Id:      r.FolderId,
+		CloudId: "cloud1",
+		Name:    "folder",
+		Status:  s.status,
+	}, nil
+}
+
 func testAccCheckFolderDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -119,6 +240,17 @@ type resourceFolderInfo struct {
 	LabelValue string
 }
 
+func testAccResourceManagerFolderDeletionProtection(info *resourceFolderInfo, deletionProtection bool) string {
+	// language=tf
+	return fmt.Sprintf(`
+resource "yandex_resourcemanager_folder" "foobar" {
+  name                = "%s"
+  description         = "%s"
+  deletion_protection = %t
+}
+`, info.Name, info.Description, deletionProtection)
+}
+
 func testAccResourceManagerFolder(info *resourceFolderInfo) string {
 	// language=tf
 	return fmt.Sprintf(`
@@ -0,0 +1,86 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func newServerlessContainerIamUpdaterByID(id string, config *Config) ResourceIamUpdater {
+	return &ServerlessContainerIamUpdater{
+		containerID: id,
+		Config:      config,
+	}
+}
+
+func TestAccServerlessContainerIamBinding_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-container")
+	role := "serverless.containers.invoker"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessContainerIamBindingBasic(name, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(serverlessContainerResource, newServerlessContainerIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_serverless_container_iam_binding.invoker",
+				ImportStateIdFunc: importIamResourceIDFunc(serverlessContainerResource, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccServerlessContainerIamMember_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-container")
+	role := "serverless.containers.invoker"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessContainerIamMemberBasic(name, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(serverlessContainerResource, newServerlessContainerIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_serverless_container_iam_member.invoker",
+				ImportStateIdFunc: importIamResourceIDFunc(serverlessContainerResource, role, member),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerlessContainerIamBindingBasic(name, role, member string) string {
+	return testYandexServerlessContainerBasic(name, "iam test", 128, serverlessContainerTestImage1) + fmt.Sprintf(`
+resource "yandex_serverless_container_iam_binding" "invoker" {
+  container_id = yandex_serverless_container.test-container.id
+  role         = "%s"
+  members      = ["%s"]
+}
+`, role, member)
+}
+
+func testAccServerlessContainerIamMemberBasic(name, role, member string) string {
+	return testYandexServerlessContainerBasic(name, "iam test", 128, serverlessContainerTestImage1) + fmt.Sprintf(`
+resource "yandex_serverless_container_iam_member" "invoker" {
+  container_id = yandex_serverless_container.test-container.id
+  role         = "%s"
+  member       = "%s"
+}
+`, role, member)
+}
@@ -32,7 +32,7 @@ func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 		Delete: resourceYandexVPCDefaultSecurityGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexVPCSecurityGroupImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -120,7 +120,7 @@ func resourceYandexVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta in
 }
 
 func resourceYandexVPCDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
+	return yandexVPCSecurityGroupRead(d, meta, d.Id(), true)
 }
 
 func resourceYandexVPCDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
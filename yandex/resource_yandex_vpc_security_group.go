@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"log"
 	"reflect"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/proto"
 )
 
 const yandexVPCSecurityGroupDefaultTimeout = 3 * time.Minute
@@ -86,7 +88,7 @@ func resourceYandexVPCSecurityGroup() *schema.Resource {
 		Update: resourceYandexVPCSecurityGroupUpdate,
 		Delete: resourceYandexVPCSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexVPCSecurityGroupImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -95,6 +97,8 @@ func resourceYandexVPCSecurityGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
 		},
 
+		CustomizeDiff: resourceYandexVPCSecurityGroupCustomizeDiff,
+
 		SchemaVersion: 0,
 		Schema:        yandexVPCSecurityGroupSchema(),
 	}
@@ -225,10 +229,36 @@ func resourceYandexVPCSecurityGroupCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
+	return yandexVPCSecurityGroupRead(d, meta, d.Id(), true)
+}
+
+// resourceYandexVPCSecurityGroupImportState puts all rules of the group into state, so that they are
+// managed by the imported resource. Rules that are not in state are skipped on read.
+func resourceYandexVPCSecurityGroupImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	securityGroup, err := config.sdk.VPC().SecurityGroup().Get(ctx, &vpc.GetSecurityGroupRequest{
+		SecurityGroupId: d.Id(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting Security group %q: %s", d.Id(), err)
+	}
+
+	ingress, egress := flattenSecurityGroupRulesSpec(securityGroup.Rules)
+	if err := d.Set("ingress", ingress); err != nil {
+		return nil, err
+	}
+	if err := d.Set("egress", egress); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
 }
 
-func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id string) error {
+func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id string, managedRulesOnly bool) error {
 	config := meta.(*Config)
 
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
@@ -261,7 +291,11 @@ func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id str
 		return err
 	}
 
-	ingress, egress := flattenSecurityGroupRulesSpec(securityGroup.Rules)
+	rules := securityGroup.Rules
+	if managedRulesOnly {
+		rules = securityGroupManagedRules(d, rules)
+	}
+	ingress, egress := flattenSecurityGroupRulesSpec(rules)
 
 	if err := d.Set("ingress", ingress); err != nil {
 		return err
@@ -332,6 +366,10 @@ func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	// rules of the group can be modified by yandex_vpc_security_group_rule resources at the same time
+	mutexKV.Lock(d.Id())
+	defer mutexKV.Unlock(d.Id())
+
 	sg, err := config.sdk.VPC().SecurityGroup().Get(ctx, &vpc.GetSecurityGroupRequest{
 		SecurityGroupId: d.Id(),
 	})
@@ -341,112 +379,221 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 	}
 
 	cloudRules := map[string]*vpc.SecurityGroupRule{}
-
 	for _, r := range sg.Rules {
 		cloudRules[r.Id] = r
 	}
 
+	// Only rules that were tracked in state are deleted: other rules of the group
+	// are managed outside of this resource, e.g. by yandex_vpc_security_group_rule.
+	managedRuleIds := map[string]bool{}
+	for _, dir := range []string{"egress", "ingress"} {
+		o, _ := d.GetChange(dir)
+		for id := range securityGroupRuleIds(o) {
+			managedRuleIds[id] = true
+		}
+	}
+
+	claimedRules := map[string]bool{}
 	newRules := make([]*vpc.SecurityGroupRuleSpec, 0)
 	delRules := make([]string, 0)
-	ruleIds := make([]string, 0)
+	updRules := make(map[string]*vpc.SecurityGroupRuleSpec)
+	var unmatchedSpecs []*vpc.SecurityGroupRuleSpec
 
 	for _, dir := range []string{"egress", "ingress"} {
-		if v, ok := d.GetOk(dir); ok {
-			for _, v := range v.(*schema.Set).List() {
-				rule, ok := v.(map[string]interface{})
-				if !ok {
-					return fmt.Errorf("fail to cast %#v to map[string]interface{}", v)
-				}
-
-				if id, ok := rule["id"].(string); ok && id != "" {
-					// existed rule
-					if cloudRule, ok := cloudRules[id]; ok {
-						ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, v)
-						if err != nil {
-							return err
-						}
-
-						if ruleChanged(cloudRule, ruleSpec) {
-							delRules = append(delRules, id)
-							newRules = append(newRules, ruleSpec)
-						} else {
-							ruleIds = append(ruleIds, id)
-						}
-
-					} else {
-						return fmt.Errorf("no rule with id %s on cloud", id)
-					}
-
-					ruleIds = append(ruleIds, id)
-				} else {
-					// new rule
-					ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, v)
-					if err != nil {
-						return err
-					}
-					newRules = append(newRules, ruleSpec)
-				}
+		for _, v := range d.Get(dir).(*schema.Set).List() {
+			rule, ok := v.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("fail to cast %#v to map[string]interface{}", v)
+			}
+
+			ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, v)
+			if err != nil {
+				return err
+			}
+
+			id, _ := rule["id"].(string)
+			cloudRule, ok := cloudRules[id]
+			if id == "" || !ok || claimedRules[id] {
+				unmatchedSpecs = append(unmatchedSpecs, ruleSpec)
+				continue
+			}
+
+			claimedRules[id] = true
+			if ruleSpecChanged(cloudRule, ruleSpec) {
+				delRules = append(delRules, id)
+				newRules = append(newRules, ruleSpec)
+			} else if ruleMetadataChanged(cloudRule, ruleSpec) {
+				updRules[id] = ruleSpec
 			}
 		}
 	}
 
-	for cid := range cloudRules {
-		found := false
-		for _, id := range ruleIds {
-			if cid == id {
-				found = true
-				break
+	// Rules without ID (e.g. rules which hash was changed) reuse identical managed rules
+	// which are not claimed by any other rule instead of recreating them.
+	for _, ruleSpec := range unmatchedSpecs {
+		if id, ok := findSecurityGroupRuleBySpec(sg.Rules, ruleSpec, func(id string) bool {
+			return managedRuleIds[id] && !claimedRules[id]
+		}); ok {
+			claimedRules[id] = true
+			if ruleMetadataChanged(cloudRules[id], ruleSpec) {
+				updRules[id] = ruleSpec
 			}
+			continue
 		}
+		newRules = append(newRules, ruleSpec)
+	}
 
-		if !found {
-			delRules = append(delRules, cid)
+	for _, r := range sg.Rules {
+		if managedRuleIds[r.Id] && !claimedRules[r.Id] {
+			delRules = append(delRules, r.Id)
 		}
 	}
 
-	req := &vpc.UpdateSecurityGroupRulesRequest{
-		SecurityGroupId:   d.Id(),
-		AdditionRuleSpecs: newRules,
-		DeletionRuleIds:   delRules,
+	if len(newRules) > 0 || len(delRules) > 0 {
+		log.Printf("[DEBUG] Updating rules of Security group %q: %d to add, %d to delete", d.Id(), len(newRules), len(delRules))
+
+		req := &vpc.UpdateSecurityGroupRulesRequest{
+			SecurityGroupId:   d.Id(),
+			AdditionRuleSpecs: newRules,
+			DeletionRuleIds:   delRules,
+		}
+		op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().UpdateRules(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to update Security group rules %q: %s", d.Id(), err)
+		}
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating Security group rules %q: %s", d.Id(), err)
+		}
 	}
-	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().UpdateRules(ctx, req))
+
+	for id, ruleSpec := range updRules {
+		if err := updateSecurityGroupRuleMetadata(ctx, config, d.Id(), id, ruleSpec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateSecurityGroupRuleMetadata(ctx context.Context, config *Config, sgId, ruleId string, ruleSpec *vpc.SecurityGroupRuleSpec) error {
+	req := &vpc.UpdateSecurityGroupRuleRequest{
+		SecurityGroupId: sgId,
+		RuleId:          ruleId,
+		Description:     ruleSpec.GetDescription(),
+		Labels:          ruleSpec.GetLabels(),
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"description", "labels"},
+		},
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().UpdateRule(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Security group rules %q: %s", d.Id(), err)
+		return fmt.Errorf("error while requesting API to update rule %q of Security group %q: %s", ruleId, sgId, err)
 	}
+
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error updating Security group rules %q: %s", d.Id(), err)
+		return fmt.Errorf("error updating rule %q of Security group %q: %s", ruleId, sgId, err)
 	}
 
 	return nil
 }
 
-func ruleChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool {
-	if r1.GetDescription() != r2.GetDescription() {
-		return true
+// securityGroupManagedRules returns rules of the group that belong to the resource: rules already
+// tracked in state and rules that were just added for rule blocks without ID. Rules added to the group
+// by other means (e.g. by yandex_vpc_security_group_rule resources) are skipped.
+func securityGroupManagedRules(d *schema.ResourceData, rules []*vpc.SecurityGroupRule) []*vpc.SecurityGroupRule {
+	knownRuleIds := map[string]bool{}
+	var pendingSpecs []*vpc.SecurityGroupRuleSpec
+
+	for _, dir := range []string{"egress", "ingress"} {
+		v, ok := d.Get(dir).(*schema.Set)
+		if !ok {
+			continue
+		}
+		for id := range securityGroupRuleIds(v) {
+			knownRuleIds[id] = true
+		}
+		for _, r := range v.List() {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, _ := rule["id"].(string); id != "" {
+				continue
+			}
+			if ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, r); err == nil {
+				pendingSpecs = append(pendingSpecs, ruleSpec)
+			}
+		}
 	}
 
-	if !reflect.DeepEqual(r1.GetLabels(), r2.GetLabels()) {
-		return true
+	claimedRules := map[string]bool{}
+	for _, ruleSpec := range pendingSpecs {
+		if id, ok := findSecurityGroupRuleBySpec(rules, ruleSpec, func(id string) bool {
+			return !knownRuleIds[id] && !claimedRules[id]
+		}); ok {
+			claimedRules[id] = true
+		}
+	}
+
+	result := make([]*vpc.SecurityGroupRule, 0, len(rules))
+	for _, r := range rules {
+		if knownRuleIds[r.Id] || claimedRules[r.Id] {
+			result = append(result, r)
+		} else {
+			log.Printf("[DEBUG] Skipping rule %q of Security group %q as it is managed outside of the resource", r.Id, d.Id())
+		}
+	}
+
+	return result
+}
+
+func securityGroupRuleIds(v interface{}) map[string]bool {
+	ids := map[string]bool{}
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return ids
+	}
+	for _, r := range set.List() {
+		if rule, ok := r.(map[string]interface{}); ok {
+			if id, _ := rule["id"].(string); id != "" {
+				ids[id] = true
+			}
+		}
+	}
+	return ids
+}
+
+func findSecurityGroupRuleBySpec(rules []*vpc.SecurityGroupRule, ruleSpec *vpc.SecurityGroupRuleSpec, filter func(id string) bool) (string, bool) {
+	for _, r := range rules {
+		if filter(r.Id) && !ruleSpecChanged(r, ruleSpec) {
+			return r.Id, true
+		}
 	}
+	return "", false
+}
 
+// ruleSpecChanged reports whether rule must be recreated to match the spec.
+func ruleSpecChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool {
 	if r1.GetDirection() != r2.GetDirection() {
 		return true
 	}
 
-	if !reflect.DeepEqual(r1.GetPorts(), r2.GetPorts()) {
+	if !proto.Equal(r1.GetPorts(), r2.GetPorts()) {
 		return true
 	}
 
-	if !reflect.DeepEqual(r1.GetCidrBlocks(), r2.GetCidrBlocks()) {
+	if !securityRuleCidrsEqual(r1.GetCidrBlocks(), r2.GetCidrBlocks()) {
 		return true
 	}
 
-	if r1.GetProtocolName() != r2.GetProtocolName() {
+	if !strings.EqualFold(r1.GetProtocolName(), r2.GetProtocolName()) {
 		return true
 	}
 
-	if r1.GetProtocolNumber() != r2.GetProtocolNumber() {
+	if r2.GetProtocolNumber() != 0 && r1.GetProtocolNumber() != r2.GetProtocolNumber() {
 		return true
 	}
 
@@ -461,6 +608,37 @@ func ruleChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool
 	return false
 }
 
+// ruleMetadataChanged reports whether rule fields that can be updated in place differ from the spec.
+func ruleMetadataChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool {
+	if r1.GetDescription() != r2.GetDescription() {
+		return true
+	}
+
+	if len(r1.GetLabels()) != len(r2.GetLabels()) {
+		return true
+	}
+
+	return len(r1.GetLabels()) > 0 && !reflect.DeepEqual(r1.GetLabels(), r2.GetLabels())
+}
+
+func securityRuleCidrsEqual(c1, c2 *vpc.CidrBlocks) bool {
+	return stringSlicesEqual(c1.GetV4CidrBlocks(), c2.GetV4CidrBlocks()) &&
+		stringSlicesEqual(c1.GetV6CidrBlocks(), c2.GetV6CidrBlocks())
+}
+
+// stringSlicesEqual treats nil and empty slices as equal
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func resourceYandexVPCSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -489,6 +667,32 @@ func resourceYandexVPCSecurityGroupDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// Rules which differ only by description or labels get the same hash and would silently collapse
+// into a single rule, so they are rejected. Rules with values unknown on plan are not checked.
+func resourceYandexVPCSecurityGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	for _, direction := range []string{"ingress", "egress"} {
+		rules := rawConfig.GetAttr(direction)
+		if rules.IsNull() || !rules.IsWhollyKnown() {
+			continue
+		}
+
+		configured := rules.LengthInt()
+		distinct := d.Get(direction).(*schema.Set).Len()
+		if configured != distinct {
+			return fmt.Errorf("%s rules of security group must differ by protocol, ports, target or CIDR blocks: "+
+				"%d rules are configured, but only %d of them remain when description and labels are ignored", direction, configured, distinct)
+		}
+	}
+
+	return nil
+}
+
+// description and labels are not hashed since they can be updated in place
 var hashableRuleNames = []string{
 	"direction",
 	"port",
@@ -496,7 +700,6 @@ var hashableRuleNames = []string{
 	"to_port",
 	"security_group_id",
 	"predefined_target",
-	"description",
 }
 
 var toUpperCaseHashableRuleNames = []string{
@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -154,6 +155,12 @@ func resourceYandexVpcSecurityGroupRuleRead(data *schema.ResourceData, meta inte
 		return err
 	}
 
+	if rule == nil {
+		log.Printf("[WARN] Removing security group rule %q because it doesn't exist anymore", data.Id())
+		data.SetId("")
+		return nil
+	}
+
 	return writeSecurityGroupRuleToData(rule, data)
 }
 
@@ -301,7 +308,7 @@ func findRule(data *schema.ResourceData, config *Config, ctx context.Context, sg
 		}
 	}
 
-	return nil, fmt.Errorf("couldn't find rule %s in security group %s", data.Id(), sg.Id)
+	return nil, nil
 }
 
 func securityRuleDescriptionToRuleSpec(dir string, v interface{}) (*vpc.SecurityGroupRuleSpec, error) {
@@ -3,6 +3,8 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
@@ -188,6 +190,201 @@ func TestAccVPCSecurityGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_ruleDescriptionInPlace(t *testing.T) {
+	t.Parallel()
+
+	var before, after vpc.SecurityGroup
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	sgName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupWithStandaloneRule(networkName, sgName, "rule description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &before),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.description", "rule description"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupWithStandaloneRule(networkName, sgName, "new rule description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &after),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.0.description", "new rule description"),
+					resource.TestCheckResourceAttrSet("yandex_vpc_security_group_rule.standalone", "id"),
+					testAccCheckVPCSecurityGroupRulesKept(&before, &after),
+				),
+			},
+			{
+				Config:   testAccVPCSecurityGroupWithStandaloneRule(networkName, sgName, "new rule description"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroup_rulesDifferOnlyByDescription(t *testing.T) {
+	t.Parallel()
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	sgName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSecurityGroupRulesDifferOnlyByDescription(networkName, sgName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("ingress rules of security group must differ"),
+			},
+		},
+	})
+}
+
+func TestSecurityGroupRuleChanges(t *testing.T) {
+	rule := &vpc.SecurityGroupRule{
+		Id:             "rule1",
+		Direction:      vpc.SecurityGroupRule_INGRESS,
+		Description:    "description",
+		ProtocolName:   "TCP",
+		ProtocolNumber: 6,
+		Ports:          &vpc.PortRange{FromPort: 80, ToPort: 80},
+		Target: &vpc.SecurityGroupRule_CidrBlocks{
+			CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/24"}},
+		},
+	}
+
+	spec := func(description string, port int64) *vpc.SecurityGroupRuleSpec {
+		return &vpc.SecurityGroupRuleSpec{
+			Direction:   vpc.SecurityGroupRule_INGRESS,
+			Description: description,
+			Labels:      map[string]string{},
+			Protocol:    &vpc.SecurityGroupRuleSpec_ProtocolName{ProtocolName: "tcp"},
+			Ports:       &vpc.PortRange{FromPort: port, ToPort: port},
+			Target: &vpc.SecurityGroupRuleSpec_CidrBlocks{
+				CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/24"}},
+			},
+		}
+	}
+
+	cases := []struct {
+		name            string
+		spec            *vpc.SecurityGroupRuleSpec
+		specChanged     bool
+		metadataChanged bool
+	}{
+		{"same", spec("description", 80), false, false},
+		{"description", spec("other description", 80), false, true},
+		{"port", spec("description", 443), true, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ruleSpecChanged(rule, tc.spec); got != tc.specChanged {
+				t.Errorf("ruleSpecChanged() = %t, want %t", got, tc.specChanged)
+			}
+			if got := ruleMetadataChanged(rule, tc.spec); got != tc.metadataChanged {
+				t.Errorf("ruleMetadataChanged() = %t, want %t", got, tc.metadataChanged)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupManagedRules(t *testing.T) {
+	newRule := func(id string, port int64) *vpc.SecurityGroupRule {
+		return &vpc.SecurityGroupRule{
+			Id:           id,
+			Direction:    vpc.SecurityGroupRule_INGRESS,
+			ProtocolName: "TCP",
+			Ports:        &vpc.PortRange{FromPort: port, ToPort: port},
+			Target: &vpc.SecurityGroupRule_CidrBlocks{
+				CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/24"}},
+			},
+		}
+	}
+	rules := []*vpc.SecurityGroupRule{newRule("managed", 80), newRule("added", 443), newRule("standalone", 22)}
+
+	stateRule := func(id string, port int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":             id,
+			"protocol":       "TCP",
+			"port":           port,
+			"from_port":      -1,
+			"to_port":        -1,
+			"v4_cidr_blocks": []interface{}{"10.0.0.0/24"},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		ingress  []interface{}
+		expected []string
+	}{
+		{
+			name:     "no inline rules + standalone rules",
+			expected: nil,
+		},
+		{
+			name:     "first inline rule",
+			ingress:  []interface{}{stateRule("", 443)},
+			expected: []string{"added"},
+		},
+		{
+			name:     "refresh",
+			ingress:  []interface{}{stateRule("managed", 80)},
+			expected: []string{"managed"},
+		},
+		{
+			name:     "after update",
+			ingress:  []interface{}{stateRule("managed", 80), stateRule("", 443)},
+			expected: []string{"managed", "added"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := resourceYandexVPCSecurityGroup().TestResourceData()
+			if err := d.Set("ingress", tc.ingress); err != nil {
+				t.Fatalf("failed to set ingress: %s", err)
+			}
+
+			var actual []string
+			for _, r := range securityGroupManagedRules(d, rules) {
+				actual = append(actual, r.Id)
+			}
+
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("securityGroupManagedRules() = %v, want %v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func testAccCheckVPCSecurityGroupRulesKept(before, after *vpc.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ids := map[string]bool{}
+		for _, r := range after.Rules {
+			ids[r.Id] = true
+		}
+
+		for _, r := range before.Rules {
+			if !ids[r.Id] {
+				return fmt.Errorf("rule %s was recreated", r.Id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckVPCSecurityGroupExists(name string, securityGroup *vpc.SecurityGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -272,6 +469,34 @@ resource "yandex_vpc_security_group" "sg2" {
 `, networkName, sg1Name, getExampleFolderID(), getExampleFolderID())
 }
 
+func testAccVPCSecurityGroupRulesDifferOnlyByDescription(networkName, sgName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg1" {
+  name       = "%s"
+  network_id = "${yandex_vpc_network.foo.id}"
+  folder_id  = "%s"
+
+  ingress {
+    description    = "rule1 description"
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = 8080
+  }
+
+  ingress {
+    description    = "rule2 description"
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = 8080
+  }
+}
+`, networkName, sgName, getExampleFolderID())
+}
+
 func testAccVPCSecurityGroupBasic2(networkName, sg1Name string) string {
 	return fmt.Sprintf(`
 resource "yandex_vpc_network" "foo" {
@@ -324,3 +549,33 @@ func testAccCheckVPCSecurityGroupDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccVPCSecurityGroupWithStandaloneRule(networkName, sgName, ruleDescription string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg1" {
+  name       = "%s"
+  network_id = yandex_vpc_network.foo.id
+  folder_id  = "%s"
+
+  ingress {
+    description    = "%s"
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = 8080
+  }
+}
+
+resource "yandex_vpc_security_group_rule" "standalone" {
+  security_group_binding = yandex_vpc_security_group.sg1.id
+  direction              = "ingress"
+  description            = "standalone rule"
+  protocol               = "TCP"
+  v4_cidr_blocks         = ["10.0.2.0/24"]
+  port                   = 22
+}
+`, networkName, sgName, getExampleFolderID(), ruleDescription)
+}
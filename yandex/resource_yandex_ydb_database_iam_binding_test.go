@@ -0,0 +1,86 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func newYDBDatabaseIamUpdaterByID(id string, config *Config) ResourceIamUpdater {
+	return &YDBDatabaseIamUpdater{
+		databaseID: id,
+		Config:     config,
+	}
+}
+
+func TestAccYDBDatabaseIamBinding_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-ydb-database")
+	role := "ydb.viewer"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccYDBDatabaseIamBindingBasic(name, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(ydbDatabaseServerlessResource, newYDBDatabaseIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_ydb_database_iam_binding.viewer",
+				ImportStateIdFunc: importIamResourceIDFunc(ydbDatabaseServerlessResource, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccYDBDatabaseIamMember_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-ydb-database")
+	role := "ydb.viewer"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccYDBDatabaseIamMemberBasic(name, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIam(ydbDatabaseServerlessResource, newYDBDatabaseIamUpdaterByID, role, []string{member}),
+				),
+			},
+			{
+				ResourceName:      "yandex_ydb_database_iam_member.viewer",
+				ImportStateIdFunc: importIamResourceIDFunc(ydbDatabaseServerlessResource, role, member),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccYDBDatabaseIamBindingBasic(name, role, member string) string {
+	return testYandexYDBDatabaseServerlessBasic(name, "iam test", "test-label", "label-value") + fmt.Sprintf(`
+resource "yandex_ydb_database_iam_binding" "viewer" {
+  database_id = yandex_ydb_database_serverless.test-ydb-database-serverless.id
+  role        = "%s"
+  members     = ["%s"]
+}
+`, role, member)
+}
+
+func testAccYDBDatabaseIamMemberBasic(name, role, member string) string {
+	return testYandexYDBDatabaseServerlessBasic(name, "iam test", "test-label", "label-value") + fmt.Sprintf(`
+resource "yandex_ydb_database_iam_member" "viewer" {
+  database_id = yandex_ydb_database_serverless.test-ydb-database-serverless.id
+  role        = "%s"
+  member      = "%s"
+}
+`, role, member)
+}
@@ -1104,9 +1104,10 @@ func expandDataprocCreateClusterConfigSpec(d *schema.ResourceData) *dataproc.Cre
 
 func expandDataprocHadoopConfig(d *schema.ResourceData) *dataproc.HadoopConfig {
 	return &dataproc.HadoopConfig{
-		Services:      expandDataprocServices(d),
-		Properties:    expandDataprocProperties(d),
-		SshPublicKeys: expandDataprocSSHPublicKeys(d),
+		Services:              expandDataprocServices(d),
+		Properties:            expandDataprocProperties(d),
+		SshPublicKeys:         expandDataprocSSHPublicKeys(d),
+		InitializationActions: expandDataprocInitializationActions(d),
 	}
 }
 
@@ -1135,6 +1136,20 @@ func expandDataprocSSHPublicKeys(d *schema.ResourceData) []string {
 	return convertStringSet(v)
 }
 
+func expandDataprocInitializationActions(d *schema.ResourceData) []*dataproc.InitializationAction {
+	list := d.Get("cluster_config.0.hadoop.0.initialization_action").([]interface{})
+	actions := make([]*dataproc.InitializationAction, len(list))
+	for i, element := range list {
+		actionSpec := element.(map[string]interface{})
+		actions[i] = &dataproc.InitializationAction{
+			Uri:     actionSpec["uri"].(string),
+			Args:    expandStringSlice(actionSpec["args"].([]interface{})),
+			Timeout: int64(actionSpec["timeout"].(int)),
+		}
+	}
+	return actions
+}
+
 func expandDataprocSubclustersSpec(d *schema.ResourceData) []*dataproc.CreateSubclusterConfigSpec {
 	rootKey := "cluster_config.0.subcluster_spec"
 	list := d.Get(rootKey).([]interface{})
@@ -1244,13 +1259,26 @@ func flattenDataprocClusterConfig(cluster *dataproc.Cluster, subclusters []*data
 func flattenDataprocHadoopConfig(config *dataproc.HadoopConfig) []map[string]interface{} {
 	return []map[string]interface{}{
 		{
-			"services":        flattenDataprocServices(config.Services),
-			"properties":      config.Properties,
-			"ssh_public_keys": config.SshPublicKeys,
+			"services":              flattenDataprocServices(config.Services),
+			"properties":            config.Properties,
+			"ssh_public_keys":       config.SshPublicKeys,
+			"initialization_action": flattenDataprocInitializationActions(config.InitializationActions),
 		},
 	}
 }
 
+func flattenDataprocInitializationActions(actions []*dataproc.InitializationAction) []interface{} {
+	result := make([]interface{}, len(actions))
+	for i, action := range actions {
+		result[i] = map[string]interface{}{
+			"uri":     action.Uri,
+			"args":    action.Args,
+			"timeout": int(action.Timeout),
+		}
+	}
+	return result
+}
+
 func flattenDataprocServices(services []dataproc.HadoopConfig_Service) []string {
 	serviceNames := make([]string, len(services))
 	for idx, service := range services {
@@ -1307,6 +1335,149 @@ func flattenDataprocAutoscalingConfig(r *dataproc.AutoscalingConfig) []map[strin
 	return []map[string]interface{}{res}
 }
 
+func expandDataprocSparkJob(spec map[string]interface{}) *dataproc.SparkJob {
+	return &dataproc.SparkJob{
+		Args:            expandStringSlice(spec["args"].([]interface{})),
+		JarFileUris:     expandStringSlice(spec["jar_file_uris"].([]interface{})),
+		FileUris:        expandStringSlice(spec["file_uris"].([]interface{})),
+		ArchiveUris:     expandStringSlice(spec["archive_uris"].([]interface{})),
+		Properties:      convertStringMap(spec["properties"].(map[string]interface{})),
+		MainJarFileUri:  spec["main_jar_file_uri"].(string),
+		MainClass:       spec["main_class"].(string),
+		Packages:        expandStringSlice(spec["packages"].([]interface{})),
+		Repositories:    expandStringSlice(spec["repositories"].([]interface{})),
+		ExcludePackages: expandStringSlice(spec["exclude_packages"].([]interface{})),
+	}
+}
+
+func expandDataprocPysparkJob(spec map[string]interface{}) *dataproc.PysparkJob {
+	return &dataproc.PysparkJob{
+		Args:              expandStringSlice(spec["args"].([]interface{})),
+		JarFileUris:       expandStringSlice(spec["jar_file_uris"].([]interface{})),
+		FileUris:          expandStringSlice(spec["file_uris"].([]interface{})),
+		ArchiveUris:       expandStringSlice(spec["archive_uris"].([]interface{})),
+		Properties:        convertStringMap(spec["properties"].(map[string]interface{})),
+		MainPythonFileUri: spec["main_python_file_uri"].(string),
+		PythonFileUris:    expandStringSlice(spec["python_file_uris"].([]interface{})),
+		Packages:          expandStringSlice(spec["packages"].([]interface{})),
+		Repositories:      expandStringSlice(spec["repositories"].([]interface{})),
+		ExcludePackages:   expandStringSlice(spec["exclude_packages"].([]interface{})),
+	}
+}
+
+func expandDataprocHiveJob(spec map[string]interface{}) *dataproc.HiveJob {
+	job := &dataproc.HiveJob{
+		Properties:        convertStringMap(spec["properties"].(map[string]interface{})),
+		ContinueOnFailure: spec["continue_on_failure"].(bool),
+		ScriptVariables:   convertStringMap(spec["script_variables"].(map[string]interface{})),
+		JarFileUris:       expandStringSlice(spec["jar_file_uris"].([]interface{})),
+	}
+	if uri := spec["query_file_uri"].(string); uri != "" {
+		job.SetQueryFileUri(uri)
+	} else {
+		job.SetQueryList(&dataproc.QueryList{
+			Queries: expandStringSlice(spec["query_list"].([]interface{})),
+		})
+	}
+	return job
+}
+
+func expandDataprocMapreduceJob(spec map[string]interface{}) *dataproc.MapreduceJob {
+	job := &dataproc.MapreduceJob{
+		Args:        expandStringSlice(spec["args"].([]interface{})),
+		JarFileUris: expandStringSlice(spec["jar_file_uris"].([]interface{})),
+		FileUris:    expandStringSlice(spec["file_uris"].([]interface{})),
+		ArchiveUris: expandStringSlice(spec["archive_uris"].([]interface{})),
+		Properties:  convertStringMap(spec["properties"].(map[string]interface{})),
+	}
+	if uri := spec["main_jar_file_uri"].(string); uri != "" {
+		job.SetMainJarFileUri(uri)
+	} else {
+		job.SetMainClass(spec["main_class"].(string))
+	}
+	return job
+}
+
+func flattenDataprocSparkJob(job *dataproc.SparkJob) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"args":              job.Args,
+			"jar_file_uris":     job.JarFileUris,
+			"file_uris":         job.FileUris,
+			"archive_uris":      job.ArchiveUris,
+			"properties":        job.Properties,
+			"main_jar_file_uri": job.MainJarFileUri,
+			"main_class":        job.MainClass,
+			"packages":          job.Packages,
+			"repositories":      job.Repositories,
+			"exclude_packages":  job.ExcludePackages,
+		},
+	}
+}
+
+func flattenDataprocPysparkJob(job *dataproc.PysparkJob) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"args":                 job.Args,
+			"jar_file_uris":        job.JarFileUris,
+			"file_uris":            job.FileUris,
+			"archive_uris":         job.ArchiveUris,
+			"properties":           job.Properties,
+			"main_python_file_uri": job.MainPythonFileUri,
+			"python_file_uris":     job.PythonFileUris,
+			"packages":             job.Packages,
+			"repositories":         job.Repositories,
+			"exclude_packages":     job.ExcludePackages,
+		},
+	}
+}
+
+func flattenDataprocHiveJob(job *dataproc.HiveJob) []map[string]interface{} {
+	res := map[string]interface{}{
+		"properties":          job.Properties,
+		"continue_on_failure": job.ContinueOnFailure,
+		"script_variables":    job.ScriptVariables,
+		"jar_file_uris":       job.JarFileUris,
+		"query_file_uri":      job.GetQueryFileUri(),
+		"query_list":          job.GetQueryList().GetQueries(),
+	}
+	return []map[string]interface{}{res}
+}
+
+func flattenDataprocMapreduceJob(job *dataproc.MapreduceJob) []map[string]interface{} {
+	res := map[string]interface{}{
+		"args":              job.Args,
+		"jar_file_uris":     job.JarFileUris,
+		"file_uris":         job.FileUris,
+		"archive_uris":      job.ArchiveUris,
+		"properties":        job.Properties,
+		"main_jar_file_uri": job.GetMainJarFileUri(),
+		"main_class":        job.GetMainClass(),
+	}
+	return []map[string]interface{}{res}
+}
+
+func flattenDataprocApplicationInfo(info *dataproc.ApplicationInfo) []map[string]interface{} {
+	if info == nil {
+		return nil
+	}
+
+	attempts := make([]map[string]interface{}, len(info.ApplicationAttempts))
+	for i, attempt := range info.ApplicationAttempts {
+		attempts[i] = map[string]interface{}{
+			"id":              attempt.Id,
+			"am_container_id": attempt.AmContainerId,
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"id":                   info.Id,
+			"application_attempts": attempts,
+		},
+	}
+}
+
 func flattenSecurityGroupRulesProto(g *vpc.SecurityGroupRule) (port, fromPort, toPort int64) {
 	port = -1
 	fromPort = -1
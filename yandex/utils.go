@@ -9,6 +9,7 @@ import (
 	"net"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -32,6 +33,7 @@ import (
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 )
 
 type instanceAction int
@@ -944,3 +946,23 @@ func expandEnum(keyName string, value string, enumValues map[string]int32) (*int
 			keyName, getJoinedKeys(getEnumValueMapKeys(enumValues)), value)
 	}
 }
+
+// labelsContain reports whether labels have all key/value pairs of selector.
+func labelsContain(labels map[string]string, selector map[string]string) bool {
+	for k, v := range selector {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// listDataSourceID builds a stable ID of a data source listing objects from its query parameters.
+func listDataSourceID(parent string, filter string, labels map[string]string) string {
+	parts := []string{parent, filter}
+	for k, v := range labels {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts[2:])
+	return strconv.Itoa(hashcode.String(strings.Join(parts, "\n")))
+}
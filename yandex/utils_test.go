@@ -115,6 +115,44 @@ func checkWithState(fn func() resource.TestCheckFunc) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckResourceIam checks the members bound to the role using the updater of the resource found in state
+func testAccCheckResourceIam(resourceName string, newUpdater func(id string, config *Config) ResourceIamUpdater, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		policy, err := newUpdater(rs.Primary.ID, config).GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+
+		actual := roleToMembersList(role, policy.Bindings)
+		sort.Strings(members)
+		sort.Strings(actual)
+
+		if reflect.DeepEqual(members, actual) {
+			return nil
+		}
+
+		return fmt.Errorf("Binding found but expected members is %v, got %v", members, actual)
+	}
+}
+
+// importIamResourceIDFunc builds an IAM import ID from the ID of the resource found in state and the given parts
+func importIamResourceIDFunc(resourceName string, parts ...string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("can't find %s in state", resourceName)
+		}
+		return strings.Join(append([]string{rs.Primary.ID}, parts...), " "), nil
+	}
+}
+
 func testAccCheckFunctionIam(resourceName, role string, members []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -217,6 +255,34 @@ func testAccCheckResourceIDField(resourceName string, idFieldName string) resour
 		return nil
 	}
 }
+
+// testAccCheckResourceID saves ID of the resource to compare it with testAccCheckResourceIDChanged later
+func testAccCheckResourceID(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckResourceIDChanged(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("Resource: %s was not replaced, ID is still %s", resourceName, *id)
+		}
+
+		return nil
+	}
+}
 func testExistsElementWithAttrTrimmedValue(resourceName, path, field, value string, fullPath *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ms := s.RootModule()
@@ -568,3 +634,25 @@ func TestParseDuration(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, r)
 }
+
+func TestLabelsContain(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "core"}
+
+	assert.True(t, labelsContain(labels, nil))
+	assert.True(t, labelsContain(labels, map[string]string{"env": "prod"}))
+	assert.True(t, labelsContain(labels, map[string]string{"env": "prod", "team": "core"}))
+	assert.False(t, labelsContain(labels, map[string]string{"env": "dev"}))
+	assert.False(t, labelsContain(labels, map[string]string{"owner": ""}))
+	assert.False(t, labelsContain(nil, map[string]string{"env": "prod"}))
+}
+
+func TestListDataSourceID(t *testing.T) {
+	id := listDataSourceID("folder1", "name=\"foo\"", map[string]string{"a": "1", "b": "2"})
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, id, listDataSourceID("folder1", "name=\"foo\"", map[string]string{"b": "2", "a": "1"}))
+	}
+	assert.NotEqual(t, id, listDataSourceID("folder2", "name=\"foo\"", map[string]string{"a": "1", "b": "2"}))
+	assert.NotEqual(t, id, listDataSourceID("folder1", "", map[string]string{"a": "1", "b": "2"}))
+	assert.NotEqual(t, id, listDataSourceID("folder1", "name=\"foo\"", map[string]string{"a": "1"}))
+}
@@ -185,3 +185,26 @@ func matchErr(errs []error, r *regexp.Regexp) bool {
 
 	return false
 }
+
+func TestValidateIamMember(t *testing.T) {
+	testCases := []struct {
+		member string
+		errors int
+	}{
+		{member: "userAccount:some_user_id", errors: 0},
+		{member: "serviceAccount:some_sa_id", errors: 0},
+		{member: "federatedUser:some_federated_user_id", errors: 0},
+		{member: "group:some_group_id", errors: 0},
+		{member: "system:allUsers", errors: 0},
+		{member: "system:group:organization:some_org_id:users", errors: 0},
+		{member: "some_user_id", errors: 1},
+		{member: "group:", errors: 1},
+		{member: ":some_group_id", errors: 1},
+		{member: "user:some_user_id", errors: 1},
+	}
+
+	for _, tc := range testCases {
+		_, es := validateIamMember(tc.member, "member")
+		assert.Equal(t, tc.errors, len(es), "unexpected errors for member %q: %v", tc.member, es)
+	}
+}